@@ -68,9 +68,13 @@ type generateCmdOption struct {
 	// Tags is the list of build tags to add to generated Go files.
 	Tags string
 
-	// DDLFilepath is the filepath of the ddl file.
+	// DDLFilepath is the filepath of the ddl file. It can be a directory or
+	// a glob pattern to read multiple ddl files.
 	DDLFilepath string
 
+	// DDLFileOrder is the order to apply multiple ddl files.
+	DDLFileOrder string
+
 	// FromDDL indicates generating from ddl flie or not.
 	FromDDL bool
 
@@ -82,6 +86,10 @@ type generateCmdOption struct {
 	// handled by yo in the generated code.
 	IgnoreTables []string
 
+	// Schemas allows the user to specify named schemas to generate in
+	// addition to the default schema.
+	Schemas []string
+
 	// Path to config file
 	ConfigFile string
 
@@ -118,6 +126,12 @@ var (
   # Generate models from DDL under the models directory with custom types
   yo generate schema.sql --from-ddl -o models --custom-types-file custom_column_types.yml
 
+  # Generate models from migration files in the migrations directory
+  yo generate migrations --from-ddl -o models
+
+  # Generate models from migration files matching a glob in lexical order
+  yo generate 'migrations/*.up.sql' --from-ddl --ddl-order lexical -o models
+
   # Generate models under the models directory
   yo generate $SPANNER_PROJECT_NAME $SPANNER_INSTANCE_NAME $SPANNER_DATABASE_NAME -o models
 
@@ -144,7 +158,11 @@ var (
 
 			var source loader.SchemaSource
 			if generateCmdOpts.FromDDL {
-				source, err = loader.NewSchemaParserSource(generateCmdOpts.DDLFilepath)
+				files, err := loader.ResolveDDLFiles(generateCmdOpts.DDLFilepath, loader.DDLFileOrder(generateCmdOpts.DDLFileOrder))
+				if err != nil {
+					return fmt.Errorf("failed to resolve ddl files: %v", err)
+				}
+				source, err = loader.NewSchemaParserSource(files...)
 				if err != nil {
 					return fmt.Errorf("failed to create spanner loader: %v", err)
 				}
@@ -163,6 +181,8 @@ var (
 				Config:       cfg,
 				IgnoreTables: generateCmdOpts.IgnoreTables,
 				IgnoreFields: generateCmdOpts.IgnoreFields,
+				Schemas:      generateCmdOpts.Schemas,
+				PackageDir:   generateCmdOpts.baseDir,
 			})
 
 			// load defs into type map
@@ -196,11 +216,13 @@ var (
 func init() {
 	generateCmd.Flags().StringVarP(&generateCmdOpts.ConfigFile, "config", "c", "", "path to Yo config file")
 	generateCmd.Flags().BoolVar(&generateCmdOpts.FromDDL, "from-ddl", false, "toggle using DDL file")
+	generateCmd.Flags().StringVar(&generateCmdOpts.DDLFileOrder, "ddl-order", string(loader.DDLFileOrderNumeric), "order to apply DDL files in a directory or a glob (numeric or lexical)")
 	generateCmd.Flags().StringVarP(&generateCmdOpts.Out, "out", "o", "", "output path or file name")
 	generateCmd.Flags().StringVar(&generateCmdOpts.Suffix, "suffix", defaultSuffix, "output file suffix")
 	generateCmd.Flags().StringVarP(&generateCmdOpts.Package, "package", "p", "", "package name used in generated Go code")
 	generateCmd.Flags().StringArrayVar(&generateCmdOpts.IgnoreFields, "ignore-fields", nil, "fields to exclude from the generated Go code types")
 	generateCmd.Flags().StringArrayVar(&generateCmdOpts.IgnoreTables, "ignore-tables", nil, "tables to exclude from the generated Go code types")
+	generateCmd.Flags().StringArrayVar(&generateCmdOpts.Schemas, "schemas", nil, "named schemas to include in addition to the default schema (\"*\" for all named schemas)")
 	generateCmd.Flags().StringVar(&generateCmdOpts.Tags, "tags", "", "build tags to add to a package header")
 	generateCmd.Flags().BoolVar(&generateCmdOpts.DisableDefaultModules, "disable-default-modules", false, "disable the default modules for code generation")
 	generateCmd.Flags().BoolVar(&generateCmdOpts.DisableFormat, "disable-format", false, "disable to apply gofmt to generated files")
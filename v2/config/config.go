@@ -22,18 +22,113 @@ package config
 type Config struct {
 	Tables      []Table      `yaml:"tables"`
 	Inflections []Inflection `yaml:"inflections"`
+
+	// DisableCommitTimestamp disables writing spanner.CommitTimestamp into
+	// commit timestamp columns in the generated mutations.
+	DisableCommitTimestamp bool `yaml:"disableCommitTimestamp"`
+
+	// FilterExpiredRows makes the generated finders filter out the rows
+	// expired by the row deletion policy but not deleted yet.
+	FilterExpiredRows bool `yaml:"filterExpiredRows"`
+
+	// ArrayElements maps the elements of ARRAY columns to the types which
+	// can be NULL. "nullable" maps them to spanner.NullXXX such as
+	// []spanner.NullString and "pointer" maps them to pointers such as
+	// []*string. The elements are not NULL if empty.
+	ArrayElements string `yaml:"arrayElements"`
+
+	// NullableFields maps nullable columns to Go types. "nullable" maps them
+	// to spanner.NullXXX such as spanner.NullString and "pointer" maps them
+	// to pointers such as *string. It is "nullable" if empty.
+	NullableFields string `yaml:"nullableFields"`
+
+	// ProtoTypes maps the types of proto bundles to Go types for PROTO and
+	// ENUM columns.
+	ProtoTypes []ProtoType `yaml:"protoTypes"`
+
+	// TypeMappings maps the columns to custom types by the types and the
+	// names. The first matching mapping is used, and the custom types of
+	// the columns in Tables precede them.
+	TypeMappings []TypeMapping `yaml:"typeMappings"`
+}
+
+// TypeMapping maps the columns which match all of the conditions to a custom
+// type. An empty condition matches all columns.
+type TypeMapping struct {
+	// SpannerType is the Spanner type without the length such as STRING and
+	// ARRAY<INT64>.
+	SpannerType string `yaml:"spannerType"`
+
+	// Nullable matches nullable columns if true and NOT NULL columns if false.
+	Nullable *bool `yaml:"nullable"`
+
+	// Column is a pattern of the column names such as *_at. The syntax is
+	// the same as path.Match.
+	Column string `yaml:"column"`
+
+	// Table is a pattern of the table names.
+	Table string `yaml:"table"`
+
+	CustomType string `yaml:"customType"`
+}
+
+// ProtoType represents a Go type of a protocol buffers message or enum
+type ProtoType struct {
+	// Name is the fully-qualified name such as examples.shipping.Order.
+	Name string `yaml:"name"`
+
+	// GoType is the Go type such as shippingpb.Order. A message column is
+	// a pointer to the type.
+	GoType string `yaml:"goType"`
+
+	// ImportPath is the import path of the Go package of GoType.
+	ImportPath string `yaml:"importPath"`
+
+	// Enum reports whether the type is an enum. It is needed for DDL files
+	// which don't tell messages from enums.
+	Enum bool `yaml:"enum"`
 }
 
 // Table represents custom type definitions
 type Table struct {
 	Name    string   `yaml:"name"`
 	Columns []Column `yaml:"columns"`
+	Indexes []Index  `yaml:"indexes"`
+
+	// GoName overrides the Go name of the struct of the table.
+	GoName string `yaml:"goName"`
+
+	// FilterExpiredRows overrides FilterExpiredRows of Config for the table
+	// if set.
+	FilterExpiredRows *bool `yaml:"filterExpiredRows"`
+
+	// NullableFields overrides NullableFields of Config for the table.
+	NullableFields string `yaml:"nullableFields"`
 }
 
 // Column represents custom type definitions
 type Column struct {
 	Name       string `yaml:"name"`
 	CustomType string `yaml:"customType"`
+
+	// GoName overrides the Go name of the field of the column.
+	GoName string `yaml:"goName"`
+
+	// DisableCommitTimestamp disables writing spanner.CommitTimestamp into
+	// the commit timestamp column in the generated mutations.
+	DisableCommitTimestamp bool `yaml:"disableCommitTimestamp"`
+
+	// ArrayElements overrides ArrayElements of Config for the column.
+	ArrayElements string `yaml:"arrayElements"`
+}
+
+// Index represents Go name definitions of an index
+type Index struct {
+	Name string `yaml:"name"`
+
+	// GoName overrides the name of the finders of the index such as
+	// FindUsersByName, which is GoName prefixed with Find.
+	GoName string `yaml:"goName"`
 }
 
 type Inflection struct {
@@ -47,14 +47,31 @@ func (a *Generator) newTemplateFuncs() template.FuncMap {
 		"hasField":   a.hasField,
 		"fieldNames": a.fieldNames,
 
+		"commitTimestampFields": a.commitTimestampFields,
+		"defaultFields":         a.defaultFields,
+		"sequenceFields":        a.sequenceFields,
+		"vectorFields":          a.vectorFields,
+		"insertReturningFields": a.insertReturningFields,
+
 		"goParam":         a.goParam,
 		"goEncodedParam":  a.goEncodedParam,
 		"goParams":        a.goParams,
 		"goEncodedParams": a.goEncodedParams,
 
-		"escape":    a.escape,
-		"toLower":   a.toLower,
-		"pluralize": a.pluralize,
+		"escape":     a.escape,
+		"toLower":    a.toLower,
+		"pluralize":  a.pluralize,
+		"docComment": a.docComment,
+		"nthParam":   a.nthParam,
+		"paramName":  a.paramName,
+		"forceIndex": a.forceIndex,
+		"orderBy":    a.orderBy,
+
+		"notExpiredQuery":        a.notExpiredQuery,
+		"insertReturningQuery":   a.insertReturningQuery,
+		"returningClause":        a.returningClause,
+		"pendingCommitTimestamp": a.pendingCommitTimestamp,
+		"graphQuery":             a.graphQuery,
 	}
 }
 
@@ -103,7 +120,7 @@ func (a *Generator) columnNames(fields []*models.Field) string {
 		if i != 0 {
 			str = str + ", "
 		}
-		str = str + internal.EscapeColumnName(f.ColumnName)
+		str = str + a.escape(f.ColumnName)
 		i++
 	}
 	return str
@@ -125,7 +142,7 @@ func (a *Generator) columnNamesWithoutHidden(fields []*models.Field) string {
 		if i != 0 {
 			str = str + ", "
 		}
-		str = str + internal.EscapeColumnName(f.ColumnName)
+		str = str + a.escape(f.ColumnName)
 		i++
 	}
 	return str
@@ -144,7 +161,7 @@ func (a *Generator) columnNamesQuery(fields []*models.Field, sep string) string
 		if i != 0 {
 			str = str + sep
 		}
-		str = str + internal.EscapeColumnName(f.ColumnName) + " = " + a.loader.NthParam(i)
+		str = str + a.escape(f.ColumnName) + " = " + a.loader.NthParam(i)
 		i++
 	}
 
@@ -230,7 +247,7 @@ func (a *Generator) columnPrefixNames(fields []*models.Field, prefix string) str
 		if i != 0 {
 			str = str + ", "
 		}
-		str = str + prefix + "." + internal.EscapeColumnName(f.ColumnName)
+		str = str + prefix + "." + a.escape(f.ColumnName)
 		i++
 	}
 
@@ -403,6 +420,73 @@ func (a *Generator) hasColumn(fields []*models.Field, name string) bool {
 	return false
 }
 
+// commitTimestampFields takes a list of fields and returns the fields which
+// spanner.CommitTimestamp is written into in mutations.
+func (a *Generator) commitTimestampFields(fields []*models.Field) []*models.Field {
+	var res []*models.Field
+	for _, f := range fields {
+		if f.UseCommitTimestamp {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// defaultFields takes a list of fields and returns the writable fields which
+// have a default value.
+func (a *Generator) defaultFields(fields []*models.Field) []*models.Field {
+	var res []*models.Field
+	for _, f := range fields {
+		if f.DefaultExpr != "" && !f.IsGenerated {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// sequenceFields takes a list of fields and returns the writable fields whose
+// default values are got from sequences.
+func (a *Generator) sequenceFields(fields []*models.Field) []*models.Field {
+	var res []*models.Field
+	for _, f := range fields {
+		if f.Sequence != nil && !f.IsGenerated {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// vectorFields takes a list of fields and returns the writable fields whose
+// vector lengths are checked, i.e. the slices with vector_length.
+func (a *Generator) vectorFields(fields []*models.Field) []*models.Field {
+	var res []*models.Field
+	for _, f := range fields {
+		if f.VectorLength > 0 && !f.IsGenerated && strings.HasPrefix(f.Type, "[]") {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// insertReturningFields takes a list of fields and returns the fields written
+// by params of the INSERT statement of insertReturningQuery. The fields from
+// sequences are assigned by the database and the commit timestamp fields are
+// written by the pending commit timestamp.
+func (a *Generator) insertReturningFields(fields []*models.Field) []*models.Field {
+	var res []*models.Field
+	for _, f := range fields {
+		if f.Sequence == nil && !f.IsGenerated && !f.UseCommitTimestamp {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
 // hasField takes a list of fields and determines if field with the specified
 // field name is in the list.
 func (a *Generator) hasField(fields []*models.Field, name string) bool {
@@ -419,22 +503,168 @@ func (a *Generator) hasField(fields []*models.Field, name string) bool {
 func (a *Generator) nullcheck(field *models.Field) string {
 	paramName := a.goParam(field.Name)
 
+	if strings.HasPrefix(field.Type, "*") {
+		return fmt.Sprintf("%s == nil", paramName)
+	}
+
 	switch field.Type {
 	case "spanner.NullInt64",
 		"spanner.NullString",
+		"spanner.NullFloat32",
 		"spanner.NullFloat64",
 		"spanner.NullBool",
 		"spanner.NullTime",
-		"spanner.NullDate":
+		"spanner.NullDate",
+		"spanner.NullUUID",
+		"spanner.NullInterval":
 		return fmt.Sprintf("%s.IsNull()", paramName)
 	}
 
 	return fmt.Sprintf("yo, ok := %s.(yoIsNull); ok && yo.IsNull()", paramName)
 }
 
-// escaped returns the ColumnName of col. It is escaped for query.
+// escaped returns the ColumnName of col. It is escaped for query. The quotes
+// for PostgreSQL are escaped as well because queries are Go string literals in
+// templates.
 func (a *Generator) escape(col string) string {
-	return internal.EscapeColumnName(col)
+	// a table name in a named schema is escaped per part
+	parts := strings.Split(col, ".")
+	for i, p := range parts {
+		if a.dialect() == models.DialectPostgreSQL {
+			parts[i] = strings.ReplaceAll(internal.QuoteIdentifier(p), `"`, `\"`)
+		} else {
+			parts[i] = internal.EscapeColumnName(p)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// orderBy returns the columns of an ORDER BY clause in the order of the index.
+// The keys of the index come first and the primary key of the table follows.
+func (a *Generator) orderBy(ix *models.Index) string {
+	var cols []string
+	keys := make(map[string]bool)
+	add := func(fields []*models.Field, directions []string) {
+		for i, f := range fields {
+			if keys[f.ColumnName] {
+				continue
+			}
+			keys[f.ColumnName] = true
+
+			col := a.escape(f.ColumnName)
+			if i < len(directions) && directions[i] == "DESC" {
+				col += " DESC"
+			}
+			cols = append(cols, col)
+		}
+	}
+
+	add(ix.Fields, ix.KeyDirections)
+	add(ix.Type.PrimaryKeyFields, ix.Type.PrimaryKeyDirections)
+
+	return strings.Join(cols, ", ")
+}
+
+// notExpiredQuery returns a condition to filter out the rows expired by the row
+// deletion policy of t. The 0-based Nth param is the time before which the rows
+// are expired. A row whose column is NULL is never expired.
+func (a *Generator) notExpiredQuery(t *models.Type, i int) string {
+	f := t.RowDeletionPolicy.Field
+	cond := a.escape(f.ColumnName) + " >= " + a.loader.NthParam(i)
+	if f.IsNotNull {
+		return cond
+	}
+	return "(" + a.escape(f.ColumnName) + " IS NULL OR " + cond + ")"
+}
+
+// insertReturningQuery returns an INSERT statement of t which returns the
+// values assigned by sequences. The params are the fields of
+// insertReturningFields in order.
+func (a *Generator) insertReturningQuery(t *models.Type) string {
+	var cols, values []string
+	for _, f := range a.insertReturningFields(t.Fields) {
+		cols = append(cols, a.escape(f.ColumnName))
+		values = append(values, a.loader.NthParam(len(values)))
+	}
+	for _, f := range a.commitTimestampFields(t.Fields) {
+		cols = append(cols, a.escape(f.ColumnName))
+		values = append(values, a.pendingCommitTimestamp())
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) %s",
+		a.escape(t.TableName), strings.Join(cols, ", "), strings.Join(values, ", "), a.returningClause(t))
+}
+
+// returningClause returns the clause of an INSERT statement of t which
+// returns the values assigned by sequences.
+func (a *Generator) returningClause(t *models.Type) string {
+	returning := "THEN RETURN"
+	if a.dialect() == models.DialectPostgreSQL {
+		returning = "RETURNING"
+	}
+	return returning + " " + a.columnNames(a.sequenceFields(t.Fields))
+}
+
+// pendingCommitTimestamp returns the function to write the commit timestamp
+// by DML.
+func (a *Generator) pendingCommitTimestamp() string {
+	if a.dialect() == models.DialectPostgreSQL {
+		return "spanner.pending_commit_timestamp()"
+	}
+	return "PENDING_COMMIT_TIMESTAMP()"
+}
+
+// graphQuery returns a GQL query of the traversal. The params are the key
+// fields of gt.From in order, and the columns of gt.To are returned.
+func (a *Generator) graphQuery(gt *models.GraphTraversal) string {
+	edge := "-[:" + a.escape(gt.Label) + "]->"
+	if gt.Incoming {
+		edge = "<-[:" + a.escape(gt.Label) + "]-"
+	}
+
+	var conds []string
+	for i, f := range gt.From.KeyFields {
+		conds = append(conds, fmt.Sprintf("a.%s = %s", a.escape(f.ColumnName), a.loader.NthParam(i)))
+	}
+
+	var cols, keys []string
+	for _, f := range gt.To.Type.Fields {
+		if f.IsHidden {
+			continue
+		}
+		col := a.escape(f.ColumnName)
+		cols = append(cols, fmt.Sprintf("b.%s AS %s", col, col))
+	}
+	for _, f := range gt.To.KeyFields {
+		keys = append(keys, "b."+a.escape(f.ColumnName))
+	}
+
+	return fmt.Sprintf("GRAPH %s MATCH (a:%s)%s(b:%s) WHERE %s RETURN %s ORDER BY %s",
+		a.escape(gt.Graph.GraphName), a.escape(gt.From.Label), edge, a.escape(gt.To.Label),
+		strings.Join(conds, " AND "), strings.Join(cols, ", "), strings.Join(keys, ", "))
+}
+
+// nthParam returns the 0-based Nth param in a query.
+func (a *Generator) nthParam(i int) string {
+	return a.loader.NthParam(i)
+}
+
+// paramName returns the key of spanner.Statement.Params for the 0-based Nth
+// param in a query.
+func (a *Generator) paramName(i int) string {
+	if a.dialect() == models.DialectPostgreSQL {
+		return fmt.Sprintf("p%d", i+1)
+	}
+	return fmt.Sprintf("param%d", i)
+}
+
+// forceIndex returns the table of a FROM clause with the hint to force to use
+// the index.
+func (a *Generator) forceIndex(table, index string) string {
+	if a.dialect() == models.DialectPostgreSQL {
+		return fmt.Sprintf("%s /*@ FORCE_INDEX = %s */", a.escape(table), index)
+	}
+	return fmt.Sprintf("%s@{FORCE_INDEX=%s}", a.escape(table), index)
 }
 
 // toLower converts s to lower case.
@@ -446,3 +676,17 @@ func (a *Generator) toLower(s string) string {
 func (a *Generator) pluralize(s string) string {
 	return a.inflector.Pluralize(s)
 }
+
+// docComment converts a comment of DDL into Go comment lines. Each line is
+// prefixed with indent.
+func (a *Generator) docComment(comment string, indent string) string {
+	lines := strings.Split(comment, "\n")
+	for i, l := range lines {
+		if l == "" {
+			lines[i] = indent + "//"
+			continue
+		}
+		lines[i] = indent + "// " + l
+	}
+	return strings.Join(lines, "\n")
+}
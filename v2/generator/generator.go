@@ -37,6 +37,13 @@ type Loader interface {
 	NthParam(i int) string
 }
 
+// dialectLoader is the optional interface of Loader that tells the SQL dialect
+// of the database. The dialect is GoogleSQL if Loader doesn't implement it.
+type dialectLoader interface {
+	// Dialect returns the SQL dialect of the database.
+	Dialect() models.Dialect
+}
+
 type GeneratorOption struct {
 	PackageName    string
 	Tags           string
@@ -88,6 +95,14 @@ type Generator struct {
 	nameConflictSuffix string
 }
 
+// dialect returns the SQL dialect of the database of the loader.
+func (g *Generator) dialect() models.Dialect {
+	if l, ok := g.loader.(dialectLoader); ok {
+		return l.Dialect()
+	}
+	return models.DialectGoogleSQL
+}
+
 func (g *Generator) newTemplateSet() *templateSet {
 	return &templateSet{
 		funcs: g.newTemplateFuncs(),
@@ -22,19 +22,32 @@ package generator
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"go.mercari.io/yo/v2/internal"
+	"go.mercari.io/yo/v2/loader"
 	"go.mercari.io/yo/v2/models"
+	"go.mercari.io/yo/v2/module"
+	"go.mercari.io/yo/v2/module/builtin"
 )
 
-type fakeLoader struct{}
+type fakeLoader struct {
+	dialect models.Dialect
+}
 
 func (*fakeLoader) NthParam(int) string {
 	return "@"
 }
 
+func (l *fakeLoader) Dialect() models.Dialect {
+	if l.dialect == "" {
+		return models.DialectGoogleSQL
+	}
+	return l.dialect
+}
+
 func newTestGenerator(t *testing.T) *Generator {
 	t.Helper()
 
@@ -116,3 +129,427 @@ func TestGenerator(t *testing.T) {
 		})
 	}
 }
+
+// generateYoDB generates yo_db.yo.go of the schema with the builtin header and
+// returns its content. The format is disabled because it removes unused imports.
+func generateYoDB(t *testing.T, schema *models.Schema) string {
+	t.Helper()
+
+	inflector, err := internal.NewInflector(nil)
+	if err != nil {
+		t.Fatalf("failed to create inflector: %v", err)
+	}
+
+	g := NewGenerator(&fakeLoader{}, inflector, GeneratorOption{
+		PackageName:    "yotest",
+		FilenameSuffix: ".yo.go",
+		BaseDir:        t.TempDir(),
+		DisableFormat:  true,
+		HeaderModule:   builtin.Header,
+		GlobalModules:  []module.Module{builtin.Interface},
+	})
+	if err := g.Generate(schema); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(g.baseDir, "yo_db.yo.go"))
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	return string(b)
+}
+
+func TestGenerator_IntArrays(t *testing.T) {
+	table := []struct {
+		name     string
+		schema   *models.Schema
+		expected bool
+	}{
+		{
+			name:     "NoIntArrays",
+			schema:   &models.Schema{},
+			expected: false,
+		},
+		{
+			name:     "IntArrays",
+			schema:   &models.Schema{UsesIntArrays: true},
+			expected: true,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			content := generateYoDB(t, tc.schema)
+			for _, s := range []string{`"google.golang.org/protobuf/types/known/structpb"`, "func yoEncodeIntArray", "type yoPrimitiveArrayDecoder"} {
+				if got := strings.Contains(content, s); got != tc.expected {
+					t.Errorf("expected %s to be generated: %v, but got %v", s, tc.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_UUID(t *testing.T) {
+	table := []struct {
+		name     string
+		schema   *models.Schema
+		expected bool
+	}{
+		{
+			name:     "NoUUID",
+			schema:   &models.Schema{},
+			expected: false,
+		},
+		{
+			name:     "UUID",
+			schema:   &models.Schema{UsesUUID: true},
+			expected: true,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			content := generateYoDB(t, tc.schema)
+			if got := strings.Contains(content, `"github.com/google/uuid"`); got != tc.expected {
+				t.Errorf("expected uuid to be imported: %v, but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDialectFuncs(t *testing.T) {
+	table := []struct {
+		dialect            models.Dialect
+		expectedEscape     string
+		expectedQualified  string
+		expectedParamName  string
+		expectedForceIndex string
+	}{
+		{
+			dialect:            models.DialectGoogleSQL,
+			expectedEscape:     "`Order`",
+			expectedQualified:  "sales.`Order`",
+			expectedParamName:  "param0",
+			expectedForceIndex: "Items@{FORCE_INDEX=ItemsByName}",
+		},
+		{
+			dialect:            models.DialectPostgreSQL,
+			expectedEscape:     `\"Order\"`,
+			expectedQualified:  `\"sales\".\"Order\"`,
+			expectedParamName:  "p1",
+			expectedForceIndex: `\"Items\" /*@ FORCE_INDEX = ItemsByName */`,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(string(tc.dialect), func(t *testing.T) {
+			g := newTestGenerator(t)
+			g.loader = &fakeLoader{dialect: tc.dialect}
+
+			if got := g.escape("Order"); got != tc.expectedEscape {
+				t.Errorf("expected %v, but got %v", tc.expectedEscape, got)
+			}
+			if got := g.escape("sales.Order"); got != tc.expectedQualified {
+				t.Errorf("expected %v, but got %v", tc.expectedQualified, got)
+			}
+			if got := g.paramName(0); got != tc.expectedParamName {
+				t.Errorf("expected %v, but got %v", tc.expectedParamName, got)
+			}
+			if got := g.forceIndex("Items", "ItemsByName"); got != tc.expectedForceIndex {
+				t.Errorf("expected %v, but got %v", tc.expectedForceIndex, got)
+			}
+		})
+	}
+}
+
+// googleSQLLoader is a Loader which doesn't implement Dialect.
+type googleSQLLoader struct{}
+
+func (*googleSQLLoader) NthParam(int) string {
+	return "@"
+}
+
+func TestDialect(t *testing.T) {
+	table := []struct {
+		name     string
+		loader   Loader
+		expected models.Dialect
+	}{
+		{
+			name:     "GoogleSQL",
+			loader:   &fakeLoader{dialect: models.DialectGoogleSQL},
+			expected: models.DialectGoogleSQL,
+		},
+		{
+			name:     "PostgreSQL",
+			loader:   &fakeLoader{dialect: models.DialectPostgreSQL},
+			expected: models.DialectPostgreSQL,
+		},
+		{
+			name:     "NoDialect",
+			loader:   &googleSQLLoader{},
+			expected: models.DialectGoogleSQL,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGenerator(t)
+			g.loader = tc.loader
+
+			if got := g.dialect(); got != tc.expected {
+				t.Errorf("expected %v, but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	id := &models.Field{Name: "ID", ColumnName: "ID"}
+	name := &models.Field{Name: "Name", ColumnName: "Name"}
+	order := &models.Field{Name: "Order", ColumnName: "Order"}
+	typ := &models.Type{
+		PrimaryKeyFields:     []*models.Field{id, order},
+		PrimaryKeyDirections: []string{"DESC", "ASC"},
+	}
+
+	table := []struct {
+		name     string
+		index    *models.Index
+		expected string
+	}{
+		{
+			name:     "PrimaryKeyFollows",
+			index:    &models.Index{Type: typ, Fields: []*models.Field{name}, KeyDirections: []string{"ASC"}},
+			expected: "Name, ID DESC, `Order`",
+		},
+		{
+			name:     "PrimaryKeyInIndexKeys",
+			index:    &models.Index{Type: typ, Fields: []*models.Field{id, name}, KeyDirections: []string{"ASC", "DESC"}},
+			expected: "ID, Name DESC, `Order`",
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGenerator(t)
+			g.loader = &fakeLoader{dialect: models.DialectGoogleSQL}
+
+			if got := g.orderBy(tc.index); got != tc.expected {
+				t.Errorf("expected %v, but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestNotExpiredQuery(t *testing.T) {
+	table := []struct {
+		name     string
+		field    *models.Field
+		expected string
+	}{
+		{
+			name:     "NotNull",
+			field:    &models.Field{Name: "CreatedAt", ColumnName: "CreatedAt", IsNotNull: true},
+			expected: "CreatedAt >= @",
+		},
+		{
+			name:     "Nullable",
+			field:    &models.Field{Name: "CreatedAt", ColumnName: "CreatedAt"},
+			expected: "(CreatedAt IS NULL OR CreatedAt >= @)",
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGenerator(t)
+			typ := &models.Type{RowDeletionPolicy: &models.RowDeletionPolicy{Field: tc.field, NumDays: 30}}
+
+			if got := g.notExpiredQuery(typ, 1); got != tc.expected {
+				t.Errorf("expected %v, but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestInsertReturningQuery(t *testing.T) {
+	seq := &models.Sequence{SequenceName: "OrderSequence", Kind: "bit_reversed_positive"}
+	typ := &models.Type{
+		TableName: "Orders",
+		Fields: []*models.Field{
+			{Name: "OrderID", ColumnName: "OrderID", IsPrimaryKey: true, Sequence: seq},
+			{Name: "Item", ColumnName: "Item"},
+			{Name: "Total", ColumnName: "Total", IsGenerated: true},
+			{Name: "CreatedAt", ColumnName: "CreatedAt", UseCommitTimestamp: true},
+		},
+	}
+
+	table := []struct {
+		dialect  models.Dialect
+		expected string
+	}{
+		{
+			dialect:  models.DialectGoogleSQL,
+			expected: "INSERT INTO Orders (Item, CreatedAt) VALUES (@, PENDING_COMMIT_TIMESTAMP()) THEN RETURN OrderID",
+		},
+		{
+			dialect:  models.DialectPostgreSQL,
+			expected: `INSERT INTO \"Orders\" (\"Item\", \"CreatedAt\") VALUES (@, spanner.pending_commit_timestamp()) RETURNING \"OrderID\"`,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(string(tc.dialect), func(t *testing.T) {
+			g := newTestGenerator(t)
+			g.loader = &fakeLoader{dialect: tc.dialect}
+
+			if got := g.insertReturningQuery(typ); got != tc.expected {
+				t.Errorf("expected %v, but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGraphQuery(t *testing.T) {
+	personID := &models.Field{Name: "ID", ColumnName: "ID", IsPrimaryKey: true}
+	accountID := &models.Field{Name: "ID", ColumnName: "ID", IsPrimaryKey: true}
+	person := &models.GraphElement{Label: "Person", KeyFields: []*models.Field{personID}}
+	account := &models.GraphElement{
+		Label: "Account",
+		Type: &models.Type{
+			Fields: []*models.Field{
+				accountID,
+				{Name: "Limit", ColumnName: "Limit"},
+				{Name: "Tokens", ColumnName: "Tokens", IsHidden: true},
+			},
+		},
+		KeyFields: []*models.Field{accountID},
+	}
+	graph := &models.PropertyGraph{GraphName: "FinGraph"}
+
+	table := []struct {
+		name     string
+		incoming bool
+		expected string
+	}{
+		{
+			name:     "Outgoing",
+			expected: "GRAPH FinGraph MATCH (a:Person)-[:Owns]->(b:Account) WHERE a.ID = @ RETURN b.ID AS ID, b.`Limit` AS `Limit` ORDER BY b.ID",
+		},
+		{
+			name:     "Incoming",
+			incoming: true,
+			expected: "GRAPH FinGraph MATCH (a:Person)<-[:Owns]-(b:Account) WHERE a.ID = @ RETURN b.ID AS ID, b.`Limit` AS `Limit` ORDER BY b.ID",
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGenerator(t)
+			gt := &models.GraphTraversal{Graph: graph, Label: "Owns", From: person, To: account, Incoming: tc.incoming}
+
+			if got := g.graphQuery(gt); got != tc.expected {
+				t.Errorf("expected %v, but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDocComment(t *testing.T) {
+	table := []struct {
+		name     string
+		comment  string
+		indent   string
+		expected string
+	}{
+		{
+			name:     "SingleLine",
+			comment:  "the name",
+			indent:   "\t",
+			expected: "\t// the name",
+		},
+		{
+			name:     "MultiLine",
+			comment:  "Singers are artists.\n\nThey sing songs.",
+			indent:   "",
+			expected: "// Singers are artists.\n//\n// They sing songs.",
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newTestGenerator(t)
+
+			if got := g.docComment(tc.comment, tc.indent); got != tc.expected {
+				t.Errorf("expected %q, but got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+type postgreSQLSource struct {
+	loader.SchemaSource
+}
+
+func (*postgreSQLSource) Dialect() models.Dialect {
+	return models.DialectPostgreSQL
+}
+
+func TestGenerator_PostgreSQLSearchIndexes(t *testing.T) {
+	const schema = `
+CREATE TABLE Articles (
+  ArticleID INT64 NOT NULL,
+  Content STRING(MAX),
+  Content_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Content)) HIDDEN,
+) PRIMARY KEY(ArticleID);
+CREATE SEARCH INDEX ArticlesIndex ON Articles(Content_Tokens);
+`
+
+	path := filepath.Join(t.TempDir(), "schema.sql")
+	if err := os.WriteFile(path, []byte(schema), 0644); err != nil {
+		t.Fatalf("failed to write schema: %v", err)
+	}
+
+	source, err := loader.NewSchemaParserSource(path)
+	if err != nil {
+		t.Fatalf("failed to create schema parser source: %v", err)
+	}
+
+	inflector, err := internal.NewInflector(nil)
+	if err != nil {
+		t.Fatalf("failed to create inflector: %v", err)
+	}
+
+	l := loader.NewTypeLoader(&postgreSQLSource{SchemaSource: source}, inflector, loader.Option{})
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	g := NewGenerator(l, inflector, GeneratorOption{
+		PackageName:    "yotest",
+		FilenameSuffix: ".yo.go",
+		BaseDir:        t.TempDir(),
+		HeaderModule:   builtin.Header,
+		GlobalModules:  []module.Module{builtin.Interface},
+		TypeModules:    []module.Module{builtin.Type, builtin.Operation, builtin.Index},
+	})
+	if err := g.Generate(s); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+
+	for _, name := range []string{"article.yo.go", "yo_db.yo.go"} {
+		b, err := os.ReadFile(filepath.Join(g.baseDir, name))
+		if err != nil {
+			t.Fatalf("failed to read file: %v", err)
+		}
+
+		// PostgreSQL-dialect databases don't support GoogleSQL searches
+		for _, s := range []string{"SEARCH(", "SCORE(", "YOSearchOptions", "ArticleSearchResult"} {
+			if strings.Contains(string(b), s) {
+				t.Errorf("expected %s not to contain %s", name, s)
+			}
+		}
+	}
+}
@@ -82,6 +82,7 @@ var (
 		"civil":    true,
 		"codes":    true,
 		"status":   true,
+		"uuid":     true,
 	}
 )
 
@@ -64,6 +64,12 @@ func CamelToScake(s string) string {
 	return snaker.CamelToSnakeIdentifier(s)
 }
 
+// QuoteIdentifier quotes an identifier for the PostgreSQL dialect. The
+// identifier is always quoted to keep its case.
+func QuoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
 // EscapeColumnName will escape a column name if using reserved keyword as column name, returning it in
 // surrounded back quotes.
 func EscapeColumnName(s string) string {
@@ -0,0 +1,382 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// knownPackages are the packages of the Go types of the columns in the
+// generated code.
+var knownPackages = map[string]string{
+	"spanner": "cloud.google.com/go/spanner",
+	"civil":   "cloud.google.com/go/civil",
+	"big":     "math/big",
+	"time":    "time",
+	"uuid":    "github.com/google/uuid",
+}
+
+// yoIntTypes are the integer types which the generated code converts from
+// and to INT64 by itself.
+var yoIntTypes = []types.Type{
+	types.Typ[types.Int8], types.Typ[types.Uint8], types.Typ[types.Int16], types.Typ[types.Uint16],
+	types.Typ[types.Int32], types.Typ[types.Uint32], types.Typ[types.Uint64],
+}
+
+// qualifiedCustomTypeRegexp matches a custom type qualified by the import path
+// such as github.com/acme/money.Amount and []*github.com/acme/money.Amount.
+var qualifiedCustomTypeRegexp = regexp.MustCompile(`^((?:\*|\[[0-9]*\])*)([^*\[\]]*/[^/]+)\.([A-Za-z_][A-Za-z0-9_]*)$`)
+
+// parseCustomType parses a custom type qualified by the import path. It returns
+// the Go type qualified by the package name, the import path and the package
+// name. The custom type is returned as it is if it is not qualified by an
+// import path.
+func parseCustomType(customType string) (string, string, string) {
+	m := qualifiedCustomTypeRegexp.FindStringSubmatch(customType)
+	if m == nil {
+		return customType, "", ""
+	}
+
+	name := importPathToName(m[2])
+	return m[1] + name + "." + m[3], m[2], name
+}
+
+// importPathToName returns the package name assumed from the import path in
+// the same way as goimports. The version suffix such as /v2 and .v3, and the
+// go- prefix are removed.
+func importPathToName(importPath string) string {
+	base := path.Base(importPath)
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil {
+			dir := path.Dir(importPath)
+			if dir != "." {
+				base = path.Base(dir)
+			}
+		}
+	}
+	base = strings.TrimPrefix(base, "go-")
+	if i := strings.IndexFunc(base, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' || r == '_')
+	}); i >= 0 {
+		base = base[:i]
+	}
+	return base
+}
+
+// errUnresolved is returned when a type cannot be resolved because the
+// package is unknown or cannot be loaded. Such a type is not checked because
+// goimports may still resolve it in the generated code.
+var errUnresolved = errors.New("unresolved")
+
+// customTypeChecker resolves custom types with the packages and checks that
+// the generated code can read and write the columns with them. The packages
+// are loaded on demand.
+type customTypeChecker struct {
+	dir      string                    // directory of the generated package. Empty if unknown
+	imports  map[string]string         // import paths by package name
+	pkgs     map[string]*types.Package // loaded packages by import path. nil if not loadable
+	local    *types.Package            // generated package
+	localErr error
+	loaded   bool // the generated package is loaded
+	encoder  *types.Interface
+	decoder  *types.Interface
+	intSlice types.Type
+}
+
+func newCustomTypeChecker(dir string, imports map[string]string) *customTypeChecker {
+	c := &customTypeChecker{
+		dir:     dir,
+		imports: make(map[string]string),
+		pkgs:    make(map[string]*types.Package),
+	}
+	for name, importPath := range knownPackages {
+		c.imports[name] = importPath
+	}
+	for name, importPath := range imports {
+		c.imports[name] = importPath
+	}
+
+	// spanner.Encoder and spanner.Decoder
+	empty := types.NewInterfaceType(nil, nil)
+	errType := types.Universe.Lookup("error").Type()
+	c.encoder = types.NewInterfaceType([]*types.Func{
+		types.NewFunc(token.NoPos, nil, "EncodeSpanner", types.NewSignatureType(nil, nil, nil, nil,
+			types.NewTuple(types.NewVar(token.NoPos, nil, "", empty), types.NewVar(token.NoPos, nil, "", errType)), false)),
+	}, nil).Complete()
+	c.decoder = types.NewInterfaceType([]*types.Func{
+		types.NewFunc(token.NoPos, nil, "DecodeSpanner", types.NewSignatureType(nil, nil, nil,
+			types.NewTuple(types.NewVar(token.NoPos, nil, "input", empty)),
+			types.NewTuple(types.NewVar(token.NoPos, nil, "", errType)), false)),
+	}, nil).Complete()
+	c.intSlice = types.NewSlice(types.Typ[types.Int64])
+
+	return c
+}
+
+// addImport adds the import path of the package name of a custom type.
+func (c *customTypeChecker) addImport(name, importPath string) {
+	c.imports[name] = importPath
+}
+
+// check checks that the custom type can be used for a column whose Go type is
+// one of bases. readOnly is true for a column of a view, which is not written.
+func (c *customTypeChecker) check(customType string, bases []string, readOnly bool) error {
+	expr, err := parser.ParseExpr(customType)
+	if err != nil {
+		return fmt.Errorf("invalid syntax: %v", err)
+	}
+
+	t, err := c.typeOf(expr)
+	if errors.Is(err, errUnresolved) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, b := range bases {
+		bexpr, err := parser.ParseExpr(b)
+		if err != nil {
+			return err
+		}
+		base, err := c.typeOf(bexpr)
+		if errors.Is(err, errUnresolved) {
+			// not checked if the types of the column are unknown
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if c.convertible(t, base) {
+			return nil
+		}
+	}
+
+	if types.Implements(types.NewPointer(t), c.decoder) && (readOnly || types.Implements(t, c.encoder)) {
+		return nil
+	}
+
+	if readOnly {
+		return fmt.Errorf("not convertible to %s and doesn't implement spanner.Decoder", bases[0])
+	}
+	return fmt.Errorf("not convertible to %s and doesn't implement spanner.Encoder and spanner.Decoder", bases[0])
+}
+
+// convertible reports whether the values of t are read and written as the
+// values of base. The spanner client converts the types of the same
+// underlying types, and the generated code converts the small integer types
+// from and to INT64.
+func (c *customTypeChecker) convertible(t, base types.Type) bool {
+	if types.Identical(t.Underlying(), base.Underlying()) {
+		return true
+	}
+
+	ts, ok1 := t.Underlying().(*types.Slice)
+	bs, ok2 := base.Underlying().(*types.Slice)
+	if ok1 && ok2 && types.Identical(ts.Elem().Underlying(), bs.Elem().Underlying()) {
+		return true
+	}
+
+	for _, it := range yoIntTypes {
+		switch {
+		case types.Identical(base, types.Typ[types.Int64]):
+			if types.Identical(t, it) {
+				return true
+			}
+		case types.Identical(base, c.intSlice):
+			if types.Identical(t, types.NewSlice(it)) || types.Identical(t, types.NewSlice(types.NewPointer(it))) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// typeOf resolves a type expression. An identifier is a predeclared type or a
+// type declared in the generated package.
+func (c *customTypeChecker) typeOf(expr ast.Expr) (types.Type, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if obj, ok := types.Universe.Lookup(e.Name).(*types.TypeName); ok {
+			return obj.Type(), nil
+		}
+		local, err := c.localPackage()
+		if err != nil {
+			return nil, err
+		}
+		if local == nil {
+			return nil, fmt.Errorf("undefined: %s", e.Name)
+		}
+		return lookupType(local, "", e.Name)
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			return nil, errUnresolved
+		}
+		pkg, err := c.loadPackage(x.Name)
+		if err != nil {
+			return nil, err
+		}
+		return lookupType(pkg, x.Name+".", e.Sel.Name)
+	case *ast.StarExpr:
+		t, err := c.typeOf(e.X)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPointer(t), nil
+	case *ast.ArrayType:
+		elem, err := c.typeOf(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		if e.Len == nil {
+			return types.NewSlice(elem), nil
+		}
+		lit, ok := e.Len.(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return nil, errUnresolved
+		}
+		n, err := strconv.ParseInt(lit.Value, 0, 64)
+		if err != nil {
+			return nil, errUnresolved
+		}
+		return types.NewArray(elem, n), nil
+	case *ast.ParenExpr:
+		return c.typeOf(e.X)
+	}
+
+	return nil, errUnresolved
+}
+
+// lookupType finds a type declared in a package. qualifier is the prefix of
+// the name in the custom type.
+func lookupType(pkg *types.Package, qualifier, name string) (types.Type, error) {
+	obj := pkg.Scope().Lookup(name)
+	if obj == nil || !obj.Exported() && qualifier != "" {
+		return nil, fmt.Errorf("undefined: %s%s", qualifier, name)
+	}
+
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s%s is not a type", qualifier, name)
+	}
+	return tn.Type(), nil
+}
+
+// localPackage loads the generated package in the directory. It returns nil
+// if the directory is unknown.
+func (c *customTypeChecker) localPackage() (*types.Package, error) {
+	if !c.loaded {
+		c.loaded = true
+		if c.dir != "" {
+			c.localErr = errUnresolved
+			if pkg := c.load("."); pkg != nil {
+				c.local, c.localErr = pkg.Types, nil
+
+				// the packages imported by the files of the generated
+				// package resolve the qualified types
+				for importPath, imp := range pkg.Imports {
+					if _, ok := c.imports[imp.Name]; !ok {
+						c.imports[imp.Name] = importPath
+					}
+					if c.imports[imp.Name] == importPath && imp.Types != nil {
+						c.pkgs[importPath] = imp.Types
+					}
+				}
+			}
+		}
+	}
+
+	return c.local, c.localErr
+}
+
+// loadPackage loads the package of the name.
+func (c *customTypeChecker) loadPackage(name string) (*types.Package, error) {
+	// the imports of the generated package are needed to know the path
+	if _, err := c.localPackage(); err != nil && !errors.Is(err, errUnresolved) {
+		return nil, err
+	}
+
+	importPath, ok := c.imports[name]
+	if !ok {
+		return nil, errUnresolved
+	}
+
+	pkg, ok := c.pkgs[importPath]
+	if !ok {
+		if p := c.load(importPath); p != nil {
+			pkg = p.Types
+		}
+		c.pkgs[importPath] = pkg
+	}
+	if pkg == nil {
+		return nil, errUnresolved
+	}
+
+	return pkg, nil
+}
+
+// load loads a package with the declarations of it and its dependencies. The
+// packages are type-checked from the source without the function bodies
+// because export data depends on the version of the Go toolchain. It returns
+// nil if the package cannot be loaded.
+func (c *customTypeChecker) load(pattern string) *packages.Package {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax,
+		Dir:  c.dir,
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			f, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+			if f != nil {
+				for _, d := range f.Decls {
+					if fd, ok := d.(*ast.FuncDecl); ok {
+						fd.Body = nil
+					}
+				}
+			}
+			return f, err
+		},
+	}
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil || len(pkgs) != 1 || pkgs[0].Types == nil {
+		return nil
+	}
+
+	// type errors are expected without the function bodies
+	for _, e := range pkgs[0].Errors {
+		if e.Kind == packages.ListError {
+			return nil
+		}
+	}
+
+	return pkgs[0]
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loader
+
+import (
+	"testing"
+)
+
+func TestCustomTypeChecker(t *testing.T) {
+	table := []struct {
+		customType  string
+		bases       []string
+		readOnly    bool
+		expectedErr string
+	}{
+		{"string", []string{"string"}, false, ""},
+		{"int8", []string{"int64"}, false, ""},
+		{"uint64", []string{"int64", "spanner.NullInt64"}, false, ""},
+		{"[]*int32", []string{"[]int64"}, false, ""},
+		{"time.Duration", []string{"int64"}, false, ""},
+		{"spanner.NullString", []string{"spanner.NullString", "string"}, false, ""},
+		{"Value", []string{"string"}, false, ""},
+		{"Values", []string{"[]string"}, false, ""},
+		{"Code", []string{"string"}, false, ""},
+		{"Label", []string{"string"}, true, ""},
+		{"decimal.Decimal", []string{"big.Rat"}, false, ""},
+		{"uint46", []string{"int64"}, false, "undefined: uint46"},
+		{"Unknown", []string{"string"}, false, "undefined: Unknown"},
+		{"spanner.NullStrin", []string{"string"}, false, "undefined: spanner.NullStrin"},
+		{"DefaultLabel", []string{"string"}, false, "DefaultLabel is not a type"},
+		{"bool", []string{"string"}, false, "not convertible to string and doesn't implement spanner.Encoder and spanner.Decoder"},
+		{"[]uint", []string{"[]int64"}, false, "not convertible to []int64 and doesn't implement spanner.Encoder and spanner.Decoder"},
+		{"*Code", []string{"string"}, false, "not convertible to string and doesn't implement spanner.Encoder and spanner.Decoder"},
+		{"Label", []string{"string"}, false, "not convertible to string and doesn't implement spanner.Encoder and spanner.Decoder"},
+		{"Values", []string{"string"}, true, "not convertible to string and doesn't implement spanner.Decoder"},
+	}
+
+	c := newCustomTypeChecker("testdata/models", nil)
+	for _, tc := range table {
+		t.Run(tc.customType, func(t *testing.T) {
+			err := c.check(tc.customType, tc.bases, tc.readOnly)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, but got nil", tc.expectedErr)
+			}
+			if err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, but got %q", tc.expectedErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseCustomType(t *testing.T) {
+	table := []struct {
+		customType         string
+		expectedType       string
+		expectedImportPath string
+		expectedPackage    string
+	}{
+		{"uint64", "uint64", "", ""},
+		{"time.Duration", "time.Duration", "", ""},
+		{"github.com/acme/money.Amount", "money.Amount", "github.com/acme/money", "money"},
+		{"*github.com/acme/money.Amount", "*money.Amount", "github.com/acme/money", "money"},
+		{"[]*github.com/acme/money.Amount", "[]*money.Amount", "github.com/acme/money", "money"},
+		{"github.com/acme/money/v2.Amount", "money.Amount", "github.com/acme/money/v2", "money"},
+		{"github.com/acme/go-money.Amount", "money.Amount", "github.com/acme/go-money", "money"},
+		{"gopkg.in/yaml.v3.Node", "yaml.Node", "gopkg.in/yaml.v3", "yaml"},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.customType, func(t *testing.T) {
+			typ, importPath, pkg := parseCustomType(tc.customType)
+			if typ != tc.expectedType {
+				t.Errorf("expected type %v, but got %v", tc.expectedType, typ)
+			}
+			if importPath != tc.expectedImportPath {
+				t.Errorf("expected import path %v, but got %v", tc.expectedImportPath, importPath)
+			}
+			if pkg != tc.expectedPackage {
+				t.Errorf("expected package %v, but got %v", tc.expectedPackage, pkg)
+			}
+		})
+	}
+}
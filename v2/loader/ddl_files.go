@@ -0,0 +1,131 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DDLFileOrder is the order to apply DDL files found in a directory or by a glob.
+type DDLFileOrder string
+
+const (
+	// DDLFileOrderNumeric sorts files by the numeric prefix of their names
+	// such as 0001_init.sql, and then by their names.
+	DDLFileOrderNumeric DDLFileOrder = "numeric"
+
+	// DDLFileOrderLexical sorts files by their names.
+	DDLFileOrderLexical DDLFileOrder = "lexical"
+)
+
+// ResolveDDLFiles returns DDL file paths specified by path in the given order.
+// path can be a file, a directory or a glob pattern. For a directory, files
+// with the .sql extension directly under it are used.
+func ResolveDDLFiles(path string, order DDLFileOrder) ([]string, error) {
+	if order != DDLFileOrderNumeric && order != DDLFileOrderLexical {
+		return nil, fmt.Errorf("unknown DDL file order %q", order)
+	}
+
+	var files []string
+	if strings.ContainsAny(path, "*?[") {
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DDL file pattern %s: %v", path, err)
+		}
+		for _, m := range matches {
+			fi, err := os.Stat(m)
+			if err != nil {
+				return nil, err
+			}
+			if !fi.IsDir() {
+				files = append(files, m)
+			}
+		}
+	} else {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			return []string{path}, nil
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.IsDir() || filepath.Ext(e.Name()) != ".sql" {
+				continue
+			}
+			files = append(files, filepath.Join(path, e.Name()))
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no DDL files found in %s", path)
+	}
+
+	switch order {
+	case DDLFileOrderNumeric:
+		sort.SliceStable(files, func(i, j int) bool {
+			ni, oki := numericPrefix(filepath.Base(files[i]))
+			nj, okj := numericPrefix(filepath.Base(files[j]))
+			switch {
+			case oki && okj && ni != nj:
+				return ni < nj
+			case oki != okj:
+				// files without a numeric prefix come last
+				return oki
+			}
+			return filepath.Base(files[i]) < filepath.Base(files[j])
+		})
+	case DDLFileOrderLexical:
+		sort.SliceStable(files, func(i, j int) bool {
+			return filepath.Base(files[i]) < filepath.Base(files[j])
+		})
+	}
+
+	return files, nil
+}
+
+// numericPrefix returns the leading number of the file name.
+func numericPrefix(name string) (uint64, bool) {
+	end := strings.IndexFunc(name, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if end == -1 {
+		end = len(name)
+	}
+	if end == 0 {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(name[:end], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveDDLFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"10_add_items.sql":       "ALTER TABLE Simple ADD COLUMN Value STRING(32);",
+		"2_add_index.sql":        "CREATE INDEX SimpleIndex ON Simple(Value)",
+		"1_init.sql":             "CREATE TABLE Simple (Id INT64 NOT NULL) PRIMARY KEY(Id);",
+		"seed.sql":               "",
+		"README.md":              "",
+		"0003_rollback.down.sql": "",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0666); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	table := []struct {
+		name        string
+		path        string
+		order       DDLFileOrder
+		expected    []string
+		expectedErr bool
+	}{
+		{
+			name:     "File",
+			path:     filepath.Join(dir, "1_init.sql"),
+			order:    DDLFileOrderNumeric,
+			expected: []string{"1_init.sql"},
+		},
+		{
+			name:     "DirectoryNumeric",
+			path:     dir,
+			order:    DDLFileOrderNumeric,
+			expected: []string{"1_init.sql", "2_add_index.sql", "0003_rollback.down.sql", "10_add_items.sql", "seed.sql"},
+		},
+		{
+			name:     "DirectoryLexical",
+			path:     dir,
+			order:    DDLFileOrderLexical,
+			expected: []string{"0003_rollback.down.sql", "10_add_items.sql", "1_init.sql", "2_add_index.sql", "seed.sql"},
+		},
+		{
+			name:     "Glob",
+			path:     filepath.Join(dir, "*_*.sql"),
+			order:    DDLFileOrderNumeric,
+			expected: []string{"1_init.sql", "2_add_index.sql", "0003_rollback.down.sql", "10_add_items.sql"},
+		},
+		{
+			name:        "NoMatch",
+			path:        filepath.Join(dir, "*.ddl"),
+			order:       DDLFileOrderNumeric,
+			expectedErr: true,
+		},
+		{
+			name:        "UnknownOrder",
+			path:        dir,
+			order:       "random",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ResolveDDLFiles(tc.path, tc.order)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected to resolve DDL files failure")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to resolve DDL files: %v", err)
+			}
+
+			var names []string
+			for _, f := range got {
+				names = append(names, filepath.Base(f))
+			}
+			if diff := cmp.Diff(tc.expected, names); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSchemaParserSource_MultipleFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	files := []string{
+		"CREATE TABLE Simple (Id INT64 NOT NULL) PRIMARY KEY(Id)",
+		"ALTER TABLE Simple ADD COLUMN Value STRING(32);\nCREATE INDEX SimpleIndex ON Simple(Value);",
+	}
+	var paths []string
+	for i, content := range files {
+		path := filepath.Join(dir, string(rune('a'+i))+".sql")
+		if err := os.WriteFile(path, []byte(content), 0666); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		paths = append(paths, path)
+	}
+
+	s, err := NewSchemaParserSource(paths...)
+	if err != nil {
+		t.Fatalf("failed to create schema parser source: %v", err)
+	}
+
+	columns, err := s.ColumnList("Simple")
+	if err != nil {
+		t.Fatalf("ColumnList failed: %v", err)
+	}
+	expected := []*SpannerColumn{
+		{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+		{FieldOrdinal: 2, ColumnName: "Value", DataType: "STRING(32)"},
+	}
+	if diff := cmp.Diff(expected, columns); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+
+	indexes, err := s.IndexList("Simple")
+	if err != nil {
+		t.Fatalf("IndexList failed: %v", err)
+	}
+	if diff := cmp.Diff([]*SpannerIndex{{IndexName: "SimpleIndex"}}, indexes); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+}
@@ -21,30 +21,124 @@ package loader
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
+
+	"go.mercari.io/yo/v2/models"
 )
 
 func NewInformationSchemaSource(client *spanner.Client) (SchemaSource, error) {
+	dialect, err := loadDialect(client)
+	if err != nil {
+		return nil, err
+	}
+
 	return &informationSchemaSource{
-		client: client,
+		client:  client,
+		dialect: dialect,
 	}, nil
 }
 
+// loadDialect loads the dialect of the database. The query is valid in both
+// dialects.
+func loadDialect(client *spanner.Client) (models.Dialect, error) {
+	ctx := context.Background()
+
+	const sqlstr = `SELECT option_value ` +
+		`FROM information_schema.database_options ` +
+		`WHERE option_name = 'database_dialect'`
+	stmt := spanner.NewStatement(sqlstr)
+
+	iter := client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return models.DialectGoogleSQL, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to load database dialect: %w", err)
+	}
+
+	var dialect string
+	if err := row.Columns(&dialect); err != nil {
+		return "", fmt.Errorf("failed to load database dialect: %w", err)
+	}
+
+	return models.Dialect(dialect), nil
+}
+
 type informationSchemaSource struct {
-	client *spanner.Client
+	client  *spanner.Client
+	dialect models.Dialect
+}
+
+func (s *informationSchemaSource) Dialect() models.Dialect {
+	return s.dialect
+}
+
+// statement creates a statement of the query for the dialect of the database.
+// The params are referred as @p1, @p2, ... in GoogleSQL and $1, $2, ... in
+// PostgreSQL. The PostgreSQL query aliases the result columns to the upper
+// case names so that both results are read in the same way.
+func (s *informationSchemaSource) statement(googleSQL, postgreSQL string, params ...interface{}) spanner.Statement {
+	sqlstr := googleSQL
+	if s.dialect == models.DialectPostgreSQL {
+		sqlstr = postgreSQL
+	}
+
+	stmt := spanner.NewStatement(sqlstr)
+	for i, p := range params {
+		stmt.Params[fmt.Sprintf("p%d", i+1)] = p
+	}
+
+	return stmt
+}
+
+// schemaName returns TABLE_SCHEMA of the named schema in INFORMATION_SCHEMA.
+// The default schema is public in PostgreSQL.
+func (s *informationSchemaSource) schemaName(schema string) string {
+	if schema == "" && s.dialect == models.DialectPostgreSQL {
+		return "public"
+	}
+	return schema
+}
+
+// qualifyName qualifies name by TABLE_SCHEMA in INFORMATION_SCHEMA.
+func (s *informationSchemaSource) qualifyName(schema, name string) string {
+	if schema == s.schemaName("") {
+		schema = ""
+	}
+	return qualifyName(schema, name)
 }
 
 func (s *informationSchemaSource) TableList() ([]*SpannerTable, error) {
 	ctx := context.Background()
 
 	const sqlstr = `SELECT ` +
-		`TABLE_NAME, PARENT_TABLE_NAME ` +
-		`FROM INFORMATION_SCHEMA.TABLES ` +
-		`WHERE TABLE_SCHEMA = "" ` +
-		`ORDER BY TABLE_NAME`
-	stmt := spanner.NewStatement(sqlstr)
+		`t.TABLE_SCHEMA, t.TABLE_NAME, t.TABLE_TYPE, t.PARENT_TABLE_NAME, t.ON_DELETE_ACTION, r.POLICY_EXPRESSION ` +
+		`FROM INFORMATION_SCHEMA.TABLES t ` +
+		`LEFT JOIN INFORMATION_SCHEMA.ROW_DELETION_POLICIES r ` +
+		`ON r.TABLE_SCHEMA = t.TABLE_SCHEMA AND r.TABLE_NAME = t.TABLE_NAME ` +
+		`WHERE t.TABLE_SCHEMA NOT IN ("INFORMATION_SCHEMA", "SPANNER_SYS") ` +
+		`ORDER BY t.TABLE_SCHEMA, t.TABLE_NAME`
+	const pgsqlstr = `SELECT ` +
+		`t.table_schema AS "TABLE_SCHEMA", t.table_name AS "TABLE_NAME", t.table_type AS "TABLE_TYPE", ` +
+		`t.parent_table_name AS "PARENT_TABLE_NAME", t.on_delete_action AS "ON_DELETE_ACTION", ` +
+		`r.policy_expression AS "POLICY_EXPRESSION" ` +
+		`FROM information_schema.tables t ` +
+		`LEFT JOIN information_schema.row_deletion_policies r ` +
+		`ON r.table_schema = t.table_schema AND r.table_name = t.table_name ` +
+		`WHERE t.table_schema NOT IN ('information_schema', 'spanner_sys', 'pg_catalog') ` +
+		`ORDER BY t.table_schema, t.table_name`
+	stmt := s.statement(sqlstr, pgsqlstr)
 
 	iter := s.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
@@ -60,22 +154,84 @@ func (s *informationSchemaSource) TableList() ([]*SpannerTable, error) {
 		}
 
 		var t SpannerTable
-		if err := row.ColumnByName("TABLE_NAME", &t.TableName); err != nil {
+		var schema, tableName string
+		if err := row.ColumnByName("TABLE_SCHEMA", &schema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("TABLE_NAME", &tableName); err != nil {
 			return nil, err
 		}
+		t.TableName = s.qualifyName(schema, tableName)
 
+		var tableType string
+		if err := row.ColumnByName("TABLE_TYPE", &tableType); err != nil {
+			return nil, err
+		}
+		t.IsView = tableType == "VIEW"
+
+		// the parent table is in the same schema
 		var parentTableName spanner.NullString
 		if err := row.ColumnByName("PARENT_TABLE_NAME", &parentTableName); err != nil {
 			return nil, err
 		}
-		t.ParentTableName = parentTableName.StringVal
+		if parentTableName.Valid {
+			t.ParentTableName = s.qualifyName(schema, parentTableName.StringVal)
+		}
+
+		var onDeleteAction spanner.NullString
+		if err := row.ColumnByName("ON_DELETE_ACTION", &onDeleteAction); err != nil {
+			return nil, err
+		}
+		t.OnDeleteAction = onDeleteAction.StringVal
+
+		var policy spanner.NullString
+		if err := row.ColumnByName("POLICY_EXPRESSION", &policy); err != nil {
+			return nil, err
+		}
+		if policy.Valid {
+			t.RowDeletionPolicyColumn, t.RowDeletionPolicyDays, err = parseRowDeletionPolicy(policy.StringVal)
+			if err != nil {
+				return nil, fmt.Errorf("row deletion policy of the table %s: %w", t.TableName, err)
+			}
+		}
 
 		res = append(res, &t)
 	}
 
+	sort.Slice(res, func(i, j int) bool {
+		return lessName(res[i].TableName, res[j].TableName)
+	})
+
 	return res, nil
 }
 
+var (
+	// OLDER_THAN(CreatedAt, INTERVAL 30 DAY)
+	rowDeletionPolicyRegexp = regexp.MustCompile(`(?i)^OLDER_THAN\(\s*([^\s,]+)\s*,\s*INTERVAL\s+(\d+)\s+DAY\s*\)$`)
+	// INTERVAL '30 DAYS' ON created_at
+	pgRowDeletionPolicyRegexp = regexp.MustCompile(`(?i)^INTERVAL\s+'(\d+)\s+DAYS?'\s+ON\s+(\S+)$`)
+)
+
+// parseRowDeletionPolicy parses POLICY_EXPRESSION of
+// INFORMATION_SCHEMA.ROW_DELETION_POLICIES into the column and the days.
+func parseRowDeletionPolicy(expr string) (string, int64, error) {
+	var column, days string
+	if m := rowDeletionPolicyRegexp.FindStringSubmatch(expr); m != nil {
+		column, days = m[1], m[2]
+	} else if m := pgRowDeletionPolicyRegexp.FindStringSubmatch(expr); m != nil {
+		column, days = m[2], m[1]
+	} else {
+		return "", 0, fmt.Errorf("unsupported policy expression: %s", expr)
+	}
+
+	n, err := strconv.ParseInt(days, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid days in the policy expression %s: %w", expr, err)
+	}
+
+	return strings.Trim(column, "`\""), n, nil
+}
+
 func (s *informationSchemaSource) ColumnList(table string) ([]*SpannerColumn, error) {
 	ctx := context.Background()
 
@@ -84,17 +240,44 @@ func (s *informationSchemaSource) ColumnList(table string) ([]*SpannerColumn, er
 		`c.COLUMN_NAME, c.ORDINAL_POSITION, c.IS_NULLABLE, c.SPANNER_TYPE, ` +
 		`EXISTS (` +
 		`  SELECT 1 FROM INFORMATION_SCHEMA.INDEX_COLUMNS ic ` +
-		`  WHERE ic.TABLE_SCHEMA = "" and ic.TABLE_NAME = c.TABLE_NAME ` +
+		`  WHERE ic.TABLE_SCHEMA = c.TABLE_SCHEMA and ic.TABLE_NAME = c.TABLE_NAME ` +
 		`  AND ic.COLUMN_NAME = c.COLUMN_NAME` +
 		`  AND ic.INDEX_NAME = "PRIMARY_KEY" ` +
 		`) IS_PRIMARY_KEY, ` +
-		`IS_GENERATED = "ALWAYS" AS IS_GENERATED ` +
+		`IS_GENERATED = "ALWAYS" AS IS_GENERATED, ` +
+		`EXISTS (` +
+		`  SELECT 1 FROM INFORMATION_SCHEMA.COLUMN_OPTIONS co ` +
+		`  WHERE co.TABLE_SCHEMA = c.TABLE_SCHEMA AND co.TABLE_NAME = c.TABLE_NAME ` +
+		`  AND co.COLUMN_NAME = c.COLUMN_NAME ` +
+		`  AND co.OPTION_NAME = "allow_commit_timestamp" AND co.OPTION_VALUE = "TRUE" ` +
+		`) ALLOW_COMMIT_TIMESTAMP, ` +
+		`c.COLUMN_DEFAULT, c.GENERATION_EXPRESSION ` +
 		`FROM INFORMATION_SCHEMA.COLUMNS c ` +
-		`WHERE c.TABLE_SCHEMA = "" AND c.TABLE_NAME = @table ` +
+		`WHERE c.TABLE_SCHEMA = @p1 AND c.TABLE_NAME = @p2 ` +
 		`ORDER BY c.ORDINAL_POSITION`
-
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["table"] = table
+	const pgsqlstr = `SELECT ` +
+		`c.column_name AS "COLUMN_NAME", c.ordinal_position AS "ORDINAL_POSITION", ` +
+		`c.is_nullable AS "IS_NULLABLE", c.spanner_type AS "SPANNER_TYPE", ` +
+		`EXISTS (` +
+		`  SELECT 1 FROM information_schema.index_columns ic ` +
+		`  WHERE ic.table_schema = c.table_schema and ic.table_name = c.table_name ` +
+		`  AND ic.column_name = c.column_name` +
+		`  AND ic.index_name = 'PRIMARY_KEY' ` +
+		`) AS "IS_PRIMARY_KEY", ` +
+		`c.is_generated = 'ALWAYS' AS "IS_GENERATED", ` +
+		`EXISTS (` +
+		`  SELECT 1 FROM information_schema.column_options co ` +
+		`  WHERE co.table_schema = c.table_schema AND co.table_name = c.table_name ` +
+		`  AND co.column_name = c.column_name ` +
+		`  AND co.option_name = 'allow_commit_timestamp' AND co.option_value = 'TRUE' ` +
+		`) AS "ALLOW_COMMIT_TIMESTAMP", ` +
+		`c.column_default AS "COLUMN_DEFAULT", c.generation_expression AS "GENERATION_EXPRESSION" ` +
+		`FROM information_schema.columns c ` +
+		`WHERE c.table_schema = $1 AND c.table_name = $2 ` +
+		`ORDER BY c.ordinal_position`
+
+	schema, name := splitName(table)
+	stmt := s.statement(sqlstr, pgsqlstr, s.schemaName(schema), name)
 
 	iter := s.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
@@ -128,12 +311,26 @@ func (s *informationSchemaSource) ColumnList(table string) ([]*SpannerColumn, er
 		if err := row.ColumnByName("SPANNER_TYPE", &c.DataType); err != nil {
 			return nil, err
 		}
+		c.DataType, c.VectorLength = splitVectorLength(c.DataType)
 		if err := row.ColumnByName("IS_PRIMARY_KEY", &c.IsPrimaryKey); err != nil {
 			return nil, err
 		}
 		if err := row.ColumnByName("IS_GENERATED", &c.IsGenerated); err != nil {
 			return nil, err
 		}
+		if err := row.ColumnByName("ALLOW_COMMIT_TIMESTAMP", &c.AllowCommitTimestamp); err != nil {
+			return nil, err
+		}
+		var columnDefault spanner.NullString
+		if err := row.ColumnByName("COLUMN_DEFAULT", &columnDefault); err != nil {
+			return nil, err
+		}
+		c.DefaultExpr = columnDefault.StringVal
+		var generationExpr spanner.NullString
+		if err := row.ColumnByName("GENERATION_EXPRESSION", &generationExpr); err != nil {
+			return nil, err
+		}
+		c.GenerationExpr = generationExpr.StringVal
 
 		res = append(res, &c)
 	}
@@ -146,15 +343,25 @@ func (s *informationSchemaSource) IndexList(table string) ([]*SpannerIndex, erro
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`INDEX_NAME, IS_UNIQUE ` +
+		`INDEX_NAME, IS_UNIQUE, IS_NULL_FILTERED, PARENT_TABLE_NAME ` +
 		`FROM INFORMATION_SCHEMA.INDEXES ` +
-		`WHERE TABLE_SCHEMA = "" ` +
+		`WHERE TABLE_SCHEMA = @p1 ` +
 		`AND INDEX_NAME != "PRIMARY_KEY" ` +
-		`AND TABLE_NAME = @table ` +
+		`AND TABLE_NAME = @p2 ` +
+		`AND INDEX_TYPE = "INDEX" ` +
 		`AND SPANNER_IS_MANAGED = FALSE `
-
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["table"] = table
+	const pgsqlstr = `SELECT ` +
+		`index_name AS "INDEX_NAME", is_unique = 'YES' AS "IS_UNIQUE", ` +
+		`is_null_filtered = 'YES' AS "IS_NULL_FILTERED", parent_table_name AS "PARENT_TABLE_NAME" ` +
+		`FROM information_schema.indexes ` +
+		`WHERE table_schema = $1 ` +
+		`AND index_name != 'PRIMARY_KEY' ` +
+		`AND table_name = $2 ` +
+		`AND index_type = 'INDEX' ` +
+		`AND spanner_is_managed = 'NO' `
+
+	schema, name := splitName(table)
+	stmt := s.statement(sqlstr, pgsqlstr, s.schemaName(schema), name)
 
 	iter := s.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
@@ -170,12 +377,140 @@ func (s *informationSchemaSource) IndexList(table string) ([]*SpannerIndex, erro
 		}
 
 		var i SpannerIndex
-		if err := row.ColumnByName("INDEX_NAME", &i.IndexName); err != nil {
+		var indexName string
+		if err := row.ColumnByName("INDEX_NAME", &indexName); err != nil {
 			return nil, err
 		}
+		i.IndexName = qualifyName(schema, indexName)
 		if err := row.ColumnByName("IS_UNIQUE", &i.IsUnique); err != nil {
 			return nil, err
 		}
+		if err := row.ColumnByName("IS_NULL_FILTERED", &i.IsNullFiltered); err != nil {
+			return nil, err
+		}
+
+		// an index is interleaved in a table in the same schema
+		var parentTableName spanner.NullString
+		if err := row.ColumnByName("PARENT_TABLE_NAME", &parentTableName); err != nil {
+			return nil, err
+		}
+		if parentTableName.StringVal != "" {
+			i.ParentTableName = qualifyName(schema, parentTableName.StringVal)
+		}
+
+		res = append(res, &i)
+	}
+
+	return res, nil
+}
+
+func (s *informationSchemaSource) SearchIndexList(table string) ([]*SpannerSearchIndex, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`INDEX_NAME ` +
+		`FROM INFORMATION_SCHEMA.INDEXES ` +
+		`WHERE TABLE_SCHEMA = @p1 ` +
+		`AND TABLE_NAME = @p2 ` +
+		`AND INDEX_TYPE = "SEARCH" ` +
+		`ORDER BY INDEX_NAME`
+	const pgsqlstr = `SELECT ` +
+		`index_name AS "INDEX_NAME" ` +
+		`FROM information_schema.indexes ` +
+		`WHERE table_schema = $1 ` +
+		`AND table_name = $2 ` +
+		`AND index_type = 'SEARCH' ` +
+		`ORDER BY index_name`
+
+	schema, name := splitName(table)
+	stmt := s.statement(sqlstr, pgsqlstr, s.schemaName(schema), name)
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var res []*SpannerSearchIndex
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var indexName string
+		if err := row.ColumnByName("INDEX_NAME", &indexName); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &SpannerSearchIndex{IndexName: qualifyName(schema, indexName)})
+	}
+
+	return res, nil
+}
+
+func (s *informationSchemaSource) VectorIndexList(table string) ([]*SpannerVectorIndex, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`i.INDEX_NAME, ic.COLUMN_NAME, o.OPTION_VALUE AS DISTANCE_TYPE ` +
+		`FROM INFORMATION_SCHEMA.INDEXES i ` +
+		`JOIN INFORMATION_SCHEMA.INDEX_COLUMNS ic ` +
+		`ON ic.TABLE_SCHEMA = i.TABLE_SCHEMA AND ic.TABLE_NAME = i.TABLE_NAME ` +
+		`AND ic.INDEX_NAME = i.INDEX_NAME AND ic.ORDINAL_POSITION IS NOT NULL ` +
+		`LEFT JOIN INFORMATION_SCHEMA.INDEX_OPTIONS o ` +
+		`ON o.TABLE_SCHEMA = i.TABLE_SCHEMA AND o.TABLE_NAME = i.TABLE_NAME ` +
+		`AND o.INDEX_NAME = i.INDEX_NAME AND o.OPTION_NAME = "distance_type" ` +
+		`WHERE i.TABLE_SCHEMA = @p1 ` +
+		`AND i.TABLE_NAME = @p2 ` +
+		`AND i.INDEX_TYPE = "VECTOR" ` +
+		`ORDER BY i.INDEX_NAME`
+	const pgsqlstr = `SELECT ` +
+		`i.index_name AS "INDEX_NAME", ic.column_name AS "COLUMN_NAME", o.option_value AS "DISTANCE_TYPE" ` +
+		`FROM information_schema.indexes i ` +
+		`JOIN information_schema.index_columns ic ` +
+		`ON ic.table_schema = i.table_schema AND ic.table_name = i.table_name ` +
+		`AND ic.index_name = i.index_name AND ic.ordinal_position IS NOT NULL ` +
+		`LEFT JOIN information_schema.index_options o ` +
+		`ON o.table_schema = i.table_schema AND o.table_name = i.table_name ` +
+		`AND o.index_name = i.index_name AND o.option_name = 'distance_type' ` +
+		`WHERE i.table_schema = $1 ` +
+		`AND i.table_name = $2 ` +
+		`AND i.index_type = 'VECTOR' ` +
+		`ORDER BY i.index_name`
+
+	schema, name := splitName(table)
+	stmt := s.statement(sqlstr, pgsqlstr, s.schemaName(schema), name)
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var res []*SpannerVectorIndex
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var i SpannerVectorIndex
+		var indexName string
+		if err := row.ColumnByName("INDEX_NAME", &indexName); err != nil {
+			return nil, err
+		}
+		i.IndexName = qualifyName(schema, indexName)
+		if err := row.ColumnByName("COLUMN_NAME", &i.ColumnName); err != nil {
+			return nil, err
+		}
+		var distanceType spanner.NullString
+		if err := row.ColumnByName("DISTANCE_TYPE", &distanceType); err != nil {
+			return nil, err
+		}
+		i.DistanceType = strings.ToUpper(strings.Trim(distanceType.StringVal, `"'`))
 
 		res = append(res, &i)
 	}
@@ -188,14 +523,20 @@ func (s *informationSchemaSource) IndexColumnList(table string, index string) ([
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`ORDINAL_POSITION, COLUMN_NAME ` +
+		`ORDINAL_POSITION, COLUMN_NAME, COLUMN_ORDERING ` +
 		`FROM INFORMATION_SCHEMA.INDEX_COLUMNS ` +
-		`WHERE TABLE_SCHEMA = "" AND INDEX_NAME = @index AND TABLE_NAME = @table ` +
+		`WHERE TABLE_SCHEMA = @p1 AND TABLE_NAME = @p2 AND INDEX_NAME = @p3 ` +
 		`ORDER BY ORDINAL_POSITION`
+	const pgsqlstr = `SELECT ` +
+		`ordinal_position AS "ORDINAL_POSITION", column_name AS "COLUMN_NAME", ` +
+		`column_ordering AS "COLUMN_ORDERING" ` +
+		`FROM information_schema.index_columns ` +
+		`WHERE table_schema = $1 AND table_name = $2 AND index_name = $3 ` +
+		`ORDER BY ordinal_position`
 
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["table"] = table
-	stmt.Params["index"] = index
+	schema, name := splitName(table)
+	_, indexName := splitName(index)
+	stmt := s.statement(sqlstr, pgsqlstr, s.schemaName(schema), name, indexName)
 
 	iter := s.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
@@ -222,9 +563,272 @@ func (s *informationSchemaSource) IndexColumnList(table string, index string) ([
 		if err := row.ColumnByName("COLUMN_NAME", &i.ColumnName); err != nil {
 			return nil, err
 		}
+		var ordering spanner.NullString
+		if err := row.ColumnByName("COLUMN_ORDERING", &ordering); err != nil {
+			return nil, err
+		}
+		i.Desc = ordering.StringVal == "DESC"
 
 		res = append(res, &i)
 	}
 
 	return res, nil
 }
+
+func (s *informationSchemaSource) ForeignKeyList(table string) ([]*SpannerForeignKey, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`rc.CONSTRAINT_NAME, kcu.COLUMN_NAME, ` +
+		`rkcu.TABLE_SCHEMA, rkcu.TABLE_NAME, rkcu.COLUMN_NAME, ` +
+		`rc.DELETE_RULE ` +
+		`FROM INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS rc ` +
+		`JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE kcu ` +
+		`  ON kcu.CONSTRAINT_SCHEMA = rc.CONSTRAINT_SCHEMA AND kcu.CONSTRAINT_NAME = rc.CONSTRAINT_NAME ` +
+		`JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE rkcu ` +
+		`  ON rkcu.CONSTRAINT_SCHEMA = rc.UNIQUE_CONSTRAINT_SCHEMA AND rkcu.CONSTRAINT_NAME = rc.UNIQUE_CONSTRAINT_NAME ` +
+		`  AND rkcu.ORDINAL_POSITION = kcu.POSITION_IN_UNIQUE_CONSTRAINT ` +
+		`WHERE kcu.TABLE_SCHEMA = @p1 AND kcu.TABLE_NAME = @p2 ` +
+		`ORDER BY rc.CONSTRAINT_NAME, kcu.ORDINAL_POSITION`
+	const pgsqlstr = `SELECT ` +
+		`rc.constraint_name, kcu.column_name, ` +
+		`rkcu.table_schema, rkcu.table_name, rkcu.column_name, ` +
+		`rc.delete_rule ` +
+		`FROM information_schema.referential_constraints rc ` +
+		`JOIN information_schema.key_column_usage kcu ` +
+		`  ON kcu.constraint_schema = rc.constraint_schema AND kcu.constraint_name = rc.constraint_name ` +
+		`JOIN information_schema.key_column_usage rkcu ` +
+		`  ON rkcu.constraint_schema = rc.unique_constraint_schema AND rkcu.constraint_name = rc.unique_constraint_name ` +
+		`  AND rkcu.ordinal_position = kcu.position_in_unique_constraint ` +
+		`WHERE kcu.table_schema = $1 AND kcu.table_name = $2 ` +
+		`ORDER BY rc.constraint_name, kcu.ordinal_position`
+
+	schema, name := splitName(table)
+	stmt := s.statement(sqlstr, pgsqlstr, s.schemaName(schema), name)
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var res []*SpannerForeignKey
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var constraintName, columnName, refSchema, refTableName, refColumnName, deleteRule string
+		if err := row.Columns(&constraintName, &columnName, &refSchema, &refTableName, &refColumnName, &deleteRule); err != nil {
+			return nil, err
+		}
+
+		// rows of a foreign key are consecutive
+		if len(res) == 0 || res[len(res)-1].ConstraintName != constraintName {
+			res = append(res, &SpannerForeignKey{
+				ConstraintName:      constraintName,
+				ReferencedTableName: s.qualifyName(refSchema, refTableName),
+				OnDeleteAction:      deleteRule,
+			})
+		}
+		fk := res[len(res)-1]
+		fk.ColumnNames = append(fk.ColumnNames, columnName)
+		fk.ReferencedColumnNames = append(fk.ReferencedColumnNames, refColumnName)
+	}
+
+	return res, nil
+}
+
+func (s *informationSchemaSource) SequenceList() ([]*SpannerSequence, error) {
+	ctx := context.Background()
+
+	const sqlstr = `SELECT ` +
+		`s.SCHEMA, s.NAME, o.OPTION_NAME, o.OPTION_VALUE ` +
+		`FROM INFORMATION_SCHEMA.SEQUENCES s ` +
+		`LEFT JOIN INFORMATION_SCHEMA.SEQUENCE_OPTIONS o ` +
+		`  ON o.SCHEMA = s.SCHEMA AND o.NAME = s.NAME ` +
+		`ORDER BY s.SCHEMA, s.NAME`
+	// a sequence of PostgreSQL is always bit_reversed_positive
+	const pgsqlstr = `SELECT ` +
+		`sequence_schema, sequence_name, 'sequence_kind', 'bit_reversed_positive' ` +
+		`FROM information_schema.sequences ` +
+		`ORDER BY sequence_schema, sequence_name`
+	stmt := s.statement(sqlstr, pgsqlstr)
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var res []*SpannerSequence
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var schema, name string
+		var optionName, optionValue spanner.NullString
+		if err := row.Columns(&schema, &name, &optionName, &optionValue); err != nil {
+			return nil, err
+		}
+
+		// rows of a sequence are consecutive
+		sequenceName := s.qualifyName(schema, name)
+		if len(res) == 0 || res[len(res)-1].SequenceName != sequenceName {
+			res = append(res, &SpannerSequence{SequenceName: sequenceName})
+		}
+		seq := res[len(res)-1]
+
+		switch optionName.StringVal {
+		case "sequence_kind":
+			seq.SequenceKind = strings.Trim(optionValue.StringVal, `"'`)
+		case "skip_range_min":
+			seq.SkipRangeMin, err = strconv.ParseInt(optionValue.StringVal, 10, 64)
+		case "skip_range_max":
+			seq.SkipRangeMax, err = strconv.ParseInt(optionValue.StringVal, 10, 64)
+		case "start_with_counter":
+			seq.StartWithCounter, err = strconv.ParseInt(optionValue.StringVal, 10, 64)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("option %s of the sequence %s: %w", optionName.StringVal, sequenceName, err)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return lessName(res[i].SequenceName, res[j].SequenceName)
+	})
+
+	return res, nil
+}
+
+// propertyGraphMetadata is PROPERTY_GRAPH_METADATA_JSON of
+// INFORMATION_SCHEMA.PROPERTY_GRAPHS.
+type propertyGraphMetadata struct {
+	NodeTables []graphElementMetadata `json:"nodeTables"`
+	EdgeTables []graphElementMetadata `json:"edgeTables"`
+}
+
+type graphElementMetadata struct {
+	Name                string   `json:"name"`
+	BaseSchemaName      string   `json:"baseSchemaName"`
+	BaseTableName       string   `json:"baseTableName"`
+	KeyColumns          []string `json:"keyColumns"`
+	LabelNames          []string `json:"labelNames"`
+	PropertyDefinitions []struct {
+		PropertyDeclarationName string `json:"propertyDeclarationName"`
+		ValueExpressionSQL      string `json:"valueExpressionSql"`
+	} `json:"propertyDefinitions"`
+	SourceNodeTable      *graphNodeReferenceMetadata `json:"sourceNodeTable"`
+	DestinationNodeTable *graphNodeReferenceMetadata `json:"destinationNodeTable"`
+}
+
+type graphNodeReferenceMetadata struct {
+	NodeTableName    string   `json:"nodeTableName"`
+	EdgeTableColumns []string `json:"edgeTableColumns"`
+	NodeTableColumns []string `json:"nodeTableColumns"`
+}
+
+func (s *informationSchemaSource) PropertyGraphList() ([]*SpannerPropertyGraph, error) {
+	// property graphs are only in GoogleSQL
+	if s.dialect == models.DialectPostgreSQL {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+
+	// PROPERTY_GRAPHS is missing in the databases which don't support graphs
+	// such as older emulators
+	const existsstr = `SELECT COUNT(*) ` +
+		`FROM INFORMATION_SCHEMA.TABLES ` +
+		`WHERE TABLE_SCHEMA = "INFORMATION_SCHEMA" AND TABLE_NAME = "PROPERTY_GRAPHS"`
+	var count int64
+	err := s.client.Single().Query(ctx, spanner.NewStatement(existsstr)).Do(func(row *spanner.Row) error {
+		return row.Columns(&count)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	const sqlstr = `SELECT ` +
+		`PROPERTY_GRAPH_SCHEMA, PROPERTY_GRAPH_NAME, TO_JSON_STRING(PROPERTY_GRAPH_METADATA_JSON) ` +
+		`FROM INFORMATION_SCHEMA.PROPERTY_GRAPHS ` +
+		`ORDER BY PROPERTY_GRAPH_SCHEMA, PROPERTY_GRAPH_NAME`
+	stmt := spanner.NewStatement(sqlstr)
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var res []*SpannerPropertyGraph
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var schema, name, metadataJSON string
+		if err := row.Columns(&schema, &name, &metadataJSON); err != nil {
+			return nil, err
+		}
+
+		graphName := s.qualifyName(schema, name)
+		var metadata propertyGraphMetadata
+		if err := json.Unmarshal([]byte(metadataJSON), &metadata); err != nil {
+			return nil, fmt.Errorf("metadata of the property graph %s: %w", graphName, err)
+		}
+
+		g := &SpannerPropertyGraph{GraphName: graphName}
+		for _, e := range metadata.NodeTables {
+			g.Nodes = append(g.Nodes, s.graphElement(e))
+		}
+		for _, e := range metadata.EdgeTables {
+			g.Edges = append(g.Edges, s.graphElement(e))
+		}
+		res = append(res, g)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return lessName(res[i].GraphName, res[j].GraphName)
+	})
+
+	return res, nil
+}
+
+// graphElement converts the metadata of an element. A property is a column
+// exposed as is if its value expression is the column of the same name.
+func (s *informationSchemaSource) graphElement(e graphElementMetadata) *SpannerGraphElement {
+	elem := &SpannerGraphElement{
+		ElementName: e.Name,
+		TableName:   s.qualifyName(e.BaseSchemaName, e.BaseTableName),
+		Labels:      e.LabelNames,
+		KeyColumns:  e.KeyColumns,
+	}
+
+	for _, p := range e.PropertyDefinitions {
+		if strings.Trim(p.ValueExpressionSQL, "`") == p.PropertyDeclarationName {
+			elem.PropertyColumns = append(elem.PropertyColumns, p.PropertyDeclarationName)
+		}
+	}
+
+	if ref := e.SourceNodeTable; ref != nil {
+		elem.SourceNode = ref.NodeTableName
+		elem.SourceKeyColumns = ref.EdgeTableColumns
+		elem.SourceNodeColumns = ref.NodeTableColumns
+	}
+	if ref := e.DestinationNodeTable; ref != nil {
+		elem.DestinationNode = ref.NodeTableName
+		elem.DestinationKeyColumns = ref.EdgeTableColumns
+		elem.DestinationNodeColumns = ref.NodeTableColumns
+	}
+
+	return elem
+}
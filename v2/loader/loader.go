@@ -21,7 +21,10 @@ package loader
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"go.mercari.io/yo/v2/config"
@@ -33,13 +36,27 @@ type Option struct {
 	Config       *config.Config
 	IgnoreFields []string
 	IgnoreTables []string
+
+	// Schemas is the list of named schemas to load in addition to the
+	// default schema. "*" loads all named schemas.
+	Schemas []string
+
+	// PackageDir is the directory of the generated package. It is used to
+	// resolve the custom types declared in the package.
+	PackageDir string
 }
 
 type SchemaSource interface {
+	Dialect() models.Dialect
 	TableList() ([]*SpannerTable, error)
 	ColumnList(string) ([]*SpannerColumn, error)
 	IndexList(string) ([]*SpannerIndex, error)
 	IndexColumnList(string, string) ([]*SpannerIndexColumn, error)
+	ForeignKeyList(string) ([]*SpannerForeignKey, error)
+	SequenceList() ([]*SpannerSequence, error)
+	SearchIndexList(string) ([]*SpannerSearchIndex, error)
+	VectorIndexList(string) ([]*SpannerVectorIndex, error)
+	PropertyGraphList() ([]*SpannerPropertyGraph, error)
 }
 
 func NewTypeLoader(source SchemaSource, inflector internal.Inflector, opt Option) *TypeLoader {
@@ -48,12 +65,21 @@ func NewTypeLoader(source SchemaSource, inflector internal.Inflector, opt Option
 		cfg = &config.Config{}
 	}
 
+	imports := make(map[string]string)
+	for _, pt := range cfg.ProtoTypes {
+		if i := strings.LastIndex(pt.GoType, "."); i > 0 && pt.ImportPath != "" {
+			imports[strings.TrimPrefix(pt.GoType[:i], "*")] = pt.ImportPath
+		}
+	}
+
 	return &TypeLoader{
 		source:       source,
 		inflector:    inflector,
 		config:       cfg,
 		ignoreFields: opt.IgnoreFields,
 		ignoreTables: opt.IgnoreTables,
+		schemas:      opt.Schemas,
+		customTypes:  newCustomTypeChecker(opt.PackageDir, imports),
 	}
 }
 
@@ -66,20 +92,68 @@ type TypeLoader struct {
 	config       *config.Config
 	ignoreFields []string
 	ignoreTables []string
+	schemas      []string
+	customTypes  *customTypeChecker
 }
 
 // NthParam satisifies Loader's NthParam.
 func (tl *TypeLoader) NthParam(i int) string {
+	if tl.Dialect() == models.DialectPostgreSQL {
+		return fmt.Sprintf("$%d", i+1)
+	}
 	return fmt.Sprintf("@param%d", i)
 }
 
+// Dialect satisifies Loader's Dialect.
+func (tl *TypeLoader) Dialect() models.Dialect {
+	return tl.source.Dialect()
+}
+
 // Mask returns the parameter mask.
 func (tl *TypeLoader) Mask() string {
 	return "?"
 }
 
-func (tl *TypeLoader) validateCustomType(dataType string, customType string) bool {
-	return true
+// loadsSchema reports whether tables in the named schema are loaded.
+func (tl *TypeLoader) loadsSchema(schema string) bool {
+	if schema == "" {
+		return true
+	}
+	for _, s := range tl.schemas {
+		if s == "*" || s == schema {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCustomType checks that the custom type can be used for the field
+// instead of its Go type. typ is the Go type of the custom type.
+func (tl *TypeLoader) validateCustomType(typeTpl *models.Type, f *models.Field, customType, typ string) error {
+	bases := []string{f.OriginalType}
+	if !f.IsNotNull && f.ProtoType == nil {
+		// a custom type of a nullable column may be the non-null type, which
+		// is checked first not to load the spanner package
+		parseType := parseSpannerType
+		if tl.Dialect() == models.DialectPostgreSQL {
+			parseType = parsePostgreSQLType
+		}
+		_, _, nonNull := parseType(f.SpannerDataType, false)
+		bases = []string{nonNull}
+
+		// the spanner client reads and writes NULL as nil of the pointer of
+		// the non-null type
+		if pointerTypes[nonNull] {
+			bases = append(bases, "*"+nonNull)
+		}
+		bases = append(bases, f.OriginalType)
+	}
+
+	if err := tl.customTypes.check(typ, bases, typeTpl.IsView); err != nil {
+		return fmt.Errorf("custom type %s of the column %s in the table %s: %v", customType, f.ColumnName, typeTpl.TableName, err)
+	}
+
+	return nil
 }
 
 // LoadSchema loads schema definitions.
@@ -98,6 +172,35 @@ func (tl *TypeLoader) LoadSchema() (*models.Schema, error) {
 
 	setIndexesToTables(tableMap, ixMap)
 
+	// load foreign keys
+	if err := tl.LoadForeignKeys(tableMap); err != nil {
+		return nil, err
+	}
+
+	// load sequences
+	sequences, err := tl.LoadSequences(tableMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// load search indexes
+	searchIndexes, err := tl.LoadSearchIndexes(tableMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// load vector indexes
+	vectorIndexes, err := tl.LoadVectorIndexes(tableMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// load property graphs
+	propertyGraphs, err := tl.LoadPropertyGraphs(tableMap)
+	if err != nil {
+		return nil, err
+	}
+
 	tables := make([]*models.Type, 0, len(tableMap))
 	for _, tbl := range tableMap {
 		tables = append(tables, tbl)
@@ -107,8 +210,27 @@ func (tl *TypeLoader) LoadSchema() (*models.Schema, error) {
 		return tables[i].Name < tables[j].Name
 	})
 
+	if err := tl.validateGoNames(tables); err != nil {
+		return nil, err
+	}
+
+	protoTypes := usedProtoTypes(tables)
+	imports, err := usedImports(tables)
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.Schema{
-		Types: tables,
+		Types:          tables,
+		Sequences:      sequences,
+		SearchIndexes:  searchIndexes,
+		VectorIndexes:  vectorIndexes,
+		PropertyGraphs: propertyGraphs,
+		Dialect:        tl.Dialect(),
+		ProtoTypes:     protoTypes,
+		Imports:        imports,
+		UsesUUID:       usesUUID(tables),
+		UsesIntArrays:  usesIntArrays(tables),
 	}, nil
 }
 
@@ -125,7 +247,8 @@ func (tl *TypeLoader) LoadTable() (map[string]*models.Type, error) {
 	// tables
 	tableMap := make(map[string]*models.Type)
 	for _, ti := range tableList {
-		ignore := false
+		schema, name := splitName(ti.TableName)
+		ignore := !tl.loadsSchema(schema)
 
 		for _, ignoreTable := range tl.ignoreTables {
 			if ignoreTable == ti.TableName {
@@ -145,10 +268,20 @@ func (tl *TypeLoader) LoadTable() (map[string]*models.Type, error) {
 
 		// create template
 		typeTpl := &models.Type{
-			Name:      internal.SingularizeIdentifier(tl.inflector, ti.TableName),
-			Fields:    []*models.Field{},
-			TableName: ti.TableName,
-			Parent:    nil,
+			Name:       internal.SnakeToCamel(schema) + internal.SingularizeIdentifier(tl.inflector, name),
+			Fields:     []*models.Field{},
+			TableName:  ti.TableName,
+			SchemaName: schema,
+			IsView:     ti.IsView,
+			Comment:    ti.Comment,
+		}
+
+		goName, err := tl.tableGoName(ti.TableName)
+		if err != nil {
+			return nil, err
+		}
+		if goName != "" {
+			typeTpl.Name = goName
 		}
 
 		// process columns
@@ -157,13 +290,24 @@ func (tl *TypeLoader) LoadTable() (map[string]*models.Type, error) {
 			return nil, err
 		}
 
-		if err := tl.loadPrimaryKeys(typeTpl); err != nil {
-			return nil, err
+		// a view has no primary key
+		if !ti.IsView {
+			if err := tl.loadPrimaryKeys(typeTpl); err != nil {
+				return nil, err
+			}
+		}
+
+		if ti.RowDeletionPolicyColumn != "" {
+			if err := tl.loadRowDeletionPolicy(typeTpl, ti); err != nil {
+				return nil, err
+			}
 		}
 
 		tableMap[ti.TableName] = typeTpl
 	}
 
+	setParentsToTables(tableMap, tableList)
+
 	// validate custom type tables
 	for _, customTable := range tl.config.Tables {
 		_, ok := tableMap[customTable.Name]
@@ -184,6 +328,7 @@ func (tl *TypeLoader) loadPrimaryKeys(typeTpl *models.Type) error {
 	}
 
 	var fields []*models.Field
+	var directions []string
 	for _, idx := range indexCols {
 		var field *models.Field
 		for _, f := range typeTpl.Fields {
@@ -199,216 +344,832 @@ func (tl *TypeLoader) loadPrimaryKeys(typeTpl *models.Type) error {
 			)
 		}
 		fields = append(fields, field)
+		directions = append(directions, keyDirection(idx.Desc))
 	}
 
 	typeTpl.PrimaryKeyFields = fields
+	typeTpl.PrimaryKeyDirections = directions
 	return nil
 }
 
-// tableCustomTypes find custom type definitions of the table
-func (tl *TypeLoader) tableCustomTypes(table string) map[string]string {
-	columnTypes := make(map[string]string)
+// arrayElements returns the mode of arrayElements in the config for the
+// column. The config of the column precedes the global one.
+func (tl *TypeLoader) arrayElements(table, column string) (string, error) {
+	mode := tl.config.ArrayElements
 	for _, tbl := range tl.config.Tables {
 		if tbl.Name != table {
 			continue
 		}
-
 		for _, col := range tbl.Columns {
-			columnTypes[col.Name] = col.CustomType
+			if col.Name == column && col.ArrayElements != "" {
+				mode = col.ArrayElements
+			}
 		}
-		break
 	}
 
-	return columnTypes
+	switch mode {
+	case "", arrayElementsNullable, arrayElementsPointer:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown arrayElements %q for the column %s of the table %s", mode, column, table)
 }
 
-// LoadColumns loads schema table/view columns.
-func (tl *TypeLoader) LoadColumns(typeTpl *models.Type) error {
-	var err error
-
-	// load columns
-	columnList, err := tl.source.ColumnList(typeTpl.TableName)
-	if err != nil {
-		return err
+// nullableFields returns the mode of nullableFields in the config for the
+// table. The config of the table precedes the global one.
+func (tl *TypeLoader) nullableFields(table string) (string, error) {
+	mode := tl.config.NullableFields
+	for _, tbl := range tl.config.Tables {
+		if tbl.Name == table && tbl.NullableFields != "" {
+			mode = tbl.NullableFields
+		}
 	}
 
-	columnTypes := tl.tableCustomTypes(typeTpl.TableName)
+	switch mode {
+	case "", nullableFieldsNullable, nullableFieldsPointer:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown nullableFields %q for the table %s", mode, table)
+}
 
-	// validate custom type columns
-	if columnTypes != nil {
-		columnSet := map[string]struct{}{}
-		for _, column := range columnList {
-			columnSet[column.ColumnName] = struct{}{}
+// tableGoName returns the Go name of the table in the config. It returns an
+// empty string if not configured.
+func (tl *TypeLoader) tableGoName(table string) (string, error) {
+	for _, tbl := range tl.config.Tables {
+		if tbl.Name != table || tbl.GoName == "" {
+			continue
 		}
-
-		for k := range columnTypes {
-			if _, ok := columnSet[k]; !ok {
-				return fmt.Errorf("unknown custom type column %s in the table %s", k, typeTpl.TableName)
-			}
+		if !isGoName(tbl.GoName) {
+			return "", fmt.Errorf("invalid goName %q of the table %s", tbl.GoName, table)
 		}
+		return tbl.GoName, nil
 	}
+	return "", nil
+}
 
-	// process columns
-	for _, c := range columnList {
-		ignore := false
-
-		for _, ignoreField := range tl.ignoreFields {
-			if ignoreField == c.ColumnName {
-				// Skip adding this field if user has specified they are not
-				// interested.
-				//
-				// This could be useful for fields which are managed by the
-				// database (e.g. automatically updated timestamps) instead of
-				// via Go code.
-				ignore = true
+// columnGoNames returns the Go names of the columns of the table in the
+// config.
+func (tl *TypeLoader) columnGoNames(table string) (map[string]string, error) {
+	goNames := make(map[string]string)
+	for _, tbl := range tl.config.Tables {
+		if tbl.Name != table {
+			continue
+		}
+		for _, col := range tbl.Columns {
+			if col.GoName == "" {
+				continue
+			}
+			if !isGoName(col.GoName) {
+				return nil, fmt.Errorf("invalid goName %q of the column %s in the table %s", col.GoName, col.Name, table)
 			}
+			goNames[col.Name] = col.GoName
 		}
+	}
+	return goNames, nil
+}
 
-		if ignore {
+// indexGoNames returns the Go names of the indexes of the table in the
+// config.
+func (tl *TypeLoader) indexGoNames(table string) (map[string]string, error) {
+	goNames := make(map[string]string)
+	for _, tbl := range tl.config.Tables {
+		if tbl.Name != table {
 			continue
 		}
-
-		len, nilVal, typ := parseSpannerType(c.DataType, !c.NotNull)
-
-		// set col info
-		f := &models.Field{
-			Name:            internal.SnakeToCamel(c.ColumnName),
-			Len:             len,
-			NullValue:       nilVal,
-			Type:            typ,
-			OriginalType:    typ,
-			ColumnName:      c.ColumnName,
-			SpannerDataType: c.DataType,
-			IsNotNull:       c.NotNull,
-			IsPrimaryKey:    c.IsPrimaryKey,
-			IsGenerated:     c.IsGenerated,
-			IsHidden:        c.IsHidden,
+		for _, ix := range tbl.Indexes {
+			if !isGoName(ix.GoName) {
+				return nil, fmt.Errorf("invalid goName %q of the index %s in the table %s", ix.GoName, ix.Name, table)
+			}
+			goNames[ix.Name] = ix.GoName
 		}
+	}
+	return goNames, nil
+}
 
-		// set custom type
-		customType, ok := columnTypes[c.ColumnName]
-		if ok && tl.validateCustomType(c.DataType, customType) {
-			f.Type = customType
+// tableCustomTypes find custom type definitions of the table
+func (tl *TypeLoader) tableCustomTypes(table string) map[string]string {
+	columnTypes := make(map[string]string)
+	for _, tbl := range tl.config.Tables {
+		if tbl.Name != table {
+			continue
 		}
 
-		// append col to template fields
-		typeTpl.Fields = append(typeTpl.Fields, f)
+		for _, col := range tbl.Columns {
+			if col.CustomType == "" {
+				continue
+			}
+			columnTypes[col.Name] = col.CustomType
+		}
+		break
 	}
 
-	return nil
+	return columnTypes
 }
 
-// LoadIndexes loads schema index definitions.
-func (tl *TypeLoader) LoadIndexes(tableMap map[string]*models.Type) (map[string]*models.Index, error) {
-	var err error
+var spannerTypeLengthRegexp = regexp.MustCompile(`\([^)]*\)`)
 
-	ixMap := map[string]*models.Index{}
-	for _, t := range tableMap {
-		// load table indexes
-		err = tl.LoadTableIndexes(t, ixMap)
-		if err != nil {
-			return nil, err
+// mappedCustomType finds the custom type of the column in the type mappings
+// of the config. It returns an empty string if no mapping matches.
+func (tl *TypeLoader) mappedCustomType(table string, c *SpannerColumn) (string, error) {
+	dataType := strings.ToUpper(spannerTypeLengthRegexp.ReplaceAllString(c.DataType, ""))
+
+	for i, m := range tl.config.TypeMappings {
+		if m.CustomType == "" {
+			return "", fmt.Errorf("no custom type in the type mapping %d", i)
+		}
+
+		if m.SpannerType != "" && !strings.EqualFold(m.SpannerType, dataType) {
+			continue
+		}
+		if m.Nullable != nil && *m.Nullable == c.NotNull {
+			continue
 		}
+		if m.Column != "" {
+			ok, err := path.Match(m.Column, c.ColumnName)
+			if err != nil {
+				return "", fmt.Errorf("invalid column pattern %q in the type mapping %d: %v", m.Column, i, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		if m.Table != "" {
+			ok, err := path.Match(m.Table, table)
+			if err != nil {
+				return "", fmt.Errorf("invalid table pattern %q in the type mapping %d: %v", m.Table, i, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		return m.CustomType, nil
 	}
 
-	return ixMap, nil
+	return "", nil
 }
 
-// LoadTableIndexes loads schema index definitions per table.
-func (tl *TypeLoader) LoadTableIndexes(typeTpl *models.Type, ixMap map[string]*models.Index) error {
-	var err error
-	var priIxLoaded bool
+var protoTypeRegexp = regexp.MustCompile(`^(PROTO|ENUM)<(.+)>$`)
 
-	// load indexes
-	indexList, err := tl.source.IndexList(typeTpl.TableName)
-	if err != nil {
-		return err
+// protoType finds the proto bundle type of a PROTO or ENUM column in the
+// config. DDL files give the bare name such as examples.Order for both of
+// PROTO<examples.Order> and ENUM<examples.Order>, so the kind is decided by
+// the config. nil is returned for other types.
+func (tl *TypeLoader) protoType(dataType string) (*models.ProtoType, error) {
+	kind, name := "", dataType
+	if strings.HasPrefix(name, "ARRAY<") {
+		name = strings.TrimSuffix(strings.TrimPrefix(name, "ARRAY<"), ">")
 	}
+	if m := protoTypeRegexp.FindStringSubmatch(name); m != nil {
+		kind, name = m[1], m[2]
+	}
+	name = strings.ReplaceAll(name, "`", "")
 
-	// process indexes
-	for _, ix := range indexList {
-		// save whether or not the primary key index was processed
-		priIxLoaded = priIxLoaded || ix.IsPrimary
-
-		// create index template
-		ixTpl := &models.Index{
-			Name:      internal.SnakeToCamel(ix.IndexName),
-			Type:      typeTpl,
-			Fields:    []*models.Field{},
-			IndexName: ix.IndexName,
-			IsUnique:  ix.IsUnique,
-			IsPrimary: ix.IsPrimary,
+	for _, pt := range tl.config.ProtoTypes {
+		if pt.Name != name {
+			continue
 		}
 
-		// load index columns
-		err = tl.LoadIndexColumns(ixTpl)
-		if err != nil {
-			return err
+		configKind := "PROTO"
+		if pt.Enum {
+			configKind = "ENUM"
+		}
+		if kind != "" && kind != configKind {
+			return nil, fmt.Errorf("proto type %s is %s but configured as %s", name, kind, configKind)
 		}
 
-		// build func name
-		ixTpl.FuncName = tl.buildIndexFuncName(ixTpl)
-		ixTpl.LegacyFuncName = tl.buildLegacyIndexFuncName(ixTpl)
-
-		ixMap[typeTpl.TableName+"_"+ix.IndexName] = ixTpl
+		return &models.ProtoType{
+			Name:       pt.Name,
+			Kind:       configKind,
+			GoType:     pt.GoType,
+			ImportPath: pt.ImportPath,
+		}, nil
 	}
 
-	return nil
+	if kind != "" || strings.Contains(name, ".") {
+		return nil, fmt.Errorf("unknown proto type %s", name)
+	}
+	return nil, nil
 }
 
-func (tl *TypeLoader) buildLegacyIndexFuncName(ixTpl *models.Index) string {
-	// build func name
-	funcName := ixTpl.Type.Name
-	if !ixTpl.IsUnique {
-		funcName = tl.inflector.Pluralize(ixTpl.Type.Name)
+// parseProtoType parses a proto bundle type into a Go type. A message is a
+// pointer which is nil for NULL. A nullable enum is a pointer as well. The
+// elements of an enum array are pointers if nullableElements is true.
+func parseProtoType(pt *models.ProtoType, array bool, nullableElements bool, nullable bool) (string, string) {
+	if array {
+		typ := "[]" + pt.GoType
+		if pt.Kind == "PROTO" || nullableElements {
+			typ = "[]*" + pt.GoType
+		}
+		if !nullable {
+			return typ + "{}", typ
+		}
+		return "nil", typ
 	}
-	funcName = funcName + "By"
 
-	// add param names
-	paramNames := make([]string, 0, len(ixTpl.Fields))
-	for _, f := range ixTpl.StoringFields {
-		paramNames = append(paramNames, f.Name)
-	}
-	for _, f := range ixTpl.Fields {
-		paramNames = append(paramNames, f.Name)
+	if pt.Kind == "ENUM" && !nullable {
+		return "0", pt.GoType
 	}
-
-	return funcName + strings.Join(paramNames, "")
+	return "nil", "*" + pt.GoType
 }
 
-func (tl *TypeLoader) buildIndexFuncName(ixTpl *models.Index) string {
-	// build func name
-	funcName := ixTpl.Type.Name
-	if !ixTpl.IsUnique {
-		funcName = tl.inflector.Pluralize(ixTpl.Type.Name)
+// yoDBNames are the exported names declared in yo_db.go of the generated
+// package.
+var yoDBNames = []string{"YODB", "YOLog", "YOSearchOptions", "YONumLeavesToSearch"}
+
+// validateGoNames checks that the Go names declared by the generated code
+// don't collide with each other, i.e. the names in the package and the fields
+// and the methods of each struct. The names may collide by goName in the
+// config or by inflection.
+func (tl *TypeLoader) validateGoNames(tables []*models.Type) error {
+	decls := map[string]string{}
+	for _, name := range yoDBNames {
+		decls[name] = "yo_db.go"
+	}
+	declare := func(name, owner string) error {
+		if o, ok := decls[name]; ok {
+			return fmt.Errorf("Go name %s of %s collides with %s", name, owner, o)
+		}
+		decls[name] = owner
+		return nil
 	}
-	return funcName + "By" + internal.SnakeToCamel(ixTpl.IndexName)
-}
-
-// LoadIndexColumns loads the index column information.
-func (tl *TypeLoader) LoadIndexColumns(ixTpl *models.Index) error {
-	var err error
 
-	// load index columns
-	indexCols, err := tl.source.IndexColumnList(ixTpl.Type.TableName, ixTpl.IndexName)
-	if err != nil {
-		return err
+	// fields and methods of the structs
+	members := map[*models.Type]map[string]string{}
+	member := func(t *models.Type, name, owner string) error {
+		if members[t] == nil {
+			members[t] = map[string]string{}
+		}
+		if o, ok := members[t][name]; ok {
+			return fmt.Errorf("Go name %s of %s collides with %s in the struct %s", name, owner, o, t.Name)
+		}
+		members[t][name] = owner
+		return nil
 	}
 
-	// process index columns
-	for _, ic := range indexCols {
-		var field *models.Field
+	// tables of the same Go name are reported in the order of the table names
+	sorted := append([]*models.Type(nil), tables...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TableName < sorted[j].TableName
+	})
 
-	fieldLoop:
-		// find field
-		for _, f := range ixTpl.Type.Fields {
-			if f.ColumnName == ic.ColumnName {
-				field = f
-				break fieldLoop
+	for _, t := range sorted {
+		owner := "the table " + t.TableName
+
+		names := []string{t.Name, t.Name + "Columns", "new" + t.Name + "_Decoder"}
+		if t.IsView {
+			names = append(names, "Find"+tl.inflector.Pluralize(t.Name))
+		} else {
+			names = append(names, "Find"+t.Name, "Read"+t.Name, t.Name+"PrimaryKeys", t.Name+"WritableColumns")
+		}
+		if t.RowDeletionPolicy != nil {
+			names = append(names, t.Name+"Retention")
+		}
+		if len(t.SearchIndexes) != 0 {
+			names = append(names, t.Name+"SearchResult")
+		}
+		for _, name := range names {
+			if err := declare(name, owner); err != nil {
+				return err
 			}
 		}
 
-		if field == nil {
+		for _, f := range t.Fields {
+			if err := member(t, f.Name, fmt.Sprintf("the column %s of the table %s", f.ColumnName, t.TableName)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, t := range sorted {
+		owner := "the table " + t.TableName
+
+		methods := []string{"columnsToPtrs"}
+		if !t.IsView {
+			methods = append(methods, "Insert", "InsertOrUpdate", "Replace", "UpdateColumns", "Delete", "columnsToValues")
+			if len(t.Fields) != len(t.PrimaryKeyFields) {
+				methods = append(methods, "Update")
+			}
+			if len(t.Children) != 0 {
+				methods = append(methods, "KeyRange")
+			}
+
+			var defaults, commitTimestamps, sequences, vectors bool
+			for _, f := range t.Fields {
+				defaults = defaults || f.DefaultExpr != "" && !f.IsGenerated
+				commitTimestamps = commitTimestamps || f.UseCommitTimestamp
+				sequences = sequences || f.Sequence != nil && !f.IsGenerated
+				vectors = vectors || f.VectorLength > 0 && !f.IsGenerated && strings.HasPrefix(f.Type, "[]")
+			}
+			if defaults {
+				methods = append(methods, "InsertWithDefaults")
+			}
+			if commitTimestamps {
+				methods = append(methods, "setCommitTimestamp")
+			}
+			if sequences {
+				methods = append(methods, "InsertReturning")
+			}
+			if vectors {
+				methods = append(methods, "Validate")
+			}
+		}
+		if t.RowDeletionPolicy != nil {
+			methods = append(methods, "ExpiresAt", "IsExpired")
+		}
+		for _, name := range methods {
+			if err := member(t, name, owner); err != nil {
+				return err
+			}
+		}
+
+		for _, c := range t.Children {
+			if err := member(t, "Read"+tl.inflector.Pluralize(c.Name), "the table "+c.TableName); err != nil {
+				return err
+			}
+		}
+		for _, fk := range t.ForeignKeys {
+			if err := member(t, "Find"+fk.FuncName, fmt.Sprintf("the foreign key %s of the table %s", fk.Name, t.TableName)); err != nil {
+				return err
+			}
+		}
+		for _, fk := range t.ReferencedBy {
+			if err := member(t, "Find"+fk.RefFuncName, fmt.Sprintf("the foreign key %s of the table %s", fk.Name, fk.Type.TableName)); err != nil {
+				return err
+			}
+		}
+		for _, gt := range t.GraphTraversals {
+			if err := member(t, gt.FuncName, fmt.Sprintf("the label %s of the property graph %s", gt.Label, gt.Graph.GraphName)); err != nil {
+				return err
+			}
+		}
+
+		for _, ix := range t.Indexes {
+			owner := fmt.Sprintf("the index %s of the table %s", ix.IndexName, t.TableName)
+			for _, name := range []string{"Find" + ix.FuncName, "Read" + ix.FuncName} {
+				if err := declare(name, owner); err != nil {
+					return err
+				}
+			}
+			if ix.Parent != nil {
+				if err := member(ix.Parent, "Find"+ix.FuncName, owner); err != nil {
+					return err
+				}
+			}
+		}
+		for _, si := range t.SearchIndexes {
+			for _, ix := range si.Searches {
+				if err := declare(ix.FuncName, fmt.Sprintf("the search index %s of the table %s", si.IndexName, t.TableName)); err != nil {
+					return err
+				}
+			}
+		}
+		for _, vs := range t.VectorSearches {
+			if err := declare(vs.FuncName, fmt.Sprintf("the vector search on the column %s of the table %s", vs.Field.ColumnName, t.TableName)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// usesUUID reports whether the fields of the tables use uuid.UUID.
+func usesUUID(tables []*models.Type) bool {
+	for _, t := range tables {
+		for _, f := range t.Fields {
+			if strings.TrimLeft(f.Type, "[]*") == "uuid.UUID" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// usesIntArrays reports whether the fields of the tables are slices of the
+// integer types which the generated code converts from and to ARRAY<INT64>.
+// []uint8 is not one of them because it is []byte for BYTES.
+func usesIntArrays(tables []*models.Type) bool {
+	for _, t := range tables {
+		for _, f := range t.Fields {
+			switch f.Type {
+			case "[]int8", "[]int16", "[]uint16", "[]int32", "[]uint32", "[]uint64",
+				"[]*int8", "[]*uint8", "[]*int16", "[]*uint16", "[]*int32", "[]*uint32", "[]*uint64":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// usedProtoTypes collects the proto bundle types used by the fields of the
+// tables.
+func usedProtoTypes(tables []*models.Type) []*models.ProtoType {
+	protoTypes := map[string]*models.ProtoType{}
+	for _, t := range tables {
+		for _, f := range t.Fields {
+			if f.ProtoType != nil {
+				protoTypes[f.ProtoType.Name] = f.ProtoType
+			}
+		}
+	}
+	if len(protoTypes) == 0 {
+		return nil
+	}
+
+	var types []*models.ProtoType
+	for _, pt := range protoTypes {
+		types = append(types, pt)
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name < types[j].Name
+	})
+
+	return types
+}
+
+// usedImports collects the import specs of the Go packages of the proto bundle
+// types and the custom types used by the fields of the tables.
+func usedImports(tables []*models.Type) ([]string, error) {
+	pkgs := map[string]string{}  // package names by import path
+	paths := map[string]string{} // import paths by package name
+	add := func(importPath, pkg string) error {
+		if importPath == "" || pkgs[importPath] != "" {
+			return nil
+		}
+		if p, ok := paths[pkg]; ok {
+			return fmt.Errorf("packages %s and %s have the same name %s", p, importPath, pkg)
+		}
+		pkgs[importPath] = pkg
+		paths[pkg] = importPath
+		return nil
+	}
+
+	for _, t := range tables {
+		for _, f := range t.Fields {
+			if pt := f.ProtoType; pt != nil {
+				pkg := path.Base(pt.ImportPath)
+				if i := strings.Index(pt.GoType, "."); i >= 0 {
+					pkg = pt.GoType[:i]
+				}
+				if err := add(pt.ImportPath, pkg); err != nil {
+					return nil, fmt.Errorf("proto type %s of the column %s in the table %s: %v", pt.Name, f.ColumnName, t.TableName, err)
+				}
+			}
+
+			if f.CustomTypeImportPath != "" {
+				if err := add(f.CustomTypeImportPath, importPathToName(f.CustomTypeImportPath)); err != nil {
+					return nil, fmt.Errorf("custom type %s of the column %s in the table %s: %v", f.Type, f.ColumnName, t.TableName, err)
+				}
+			}
+		}
+	}
+
+	var imports []string
+	for importPath, pkg := range pkgs {
+		spec := strconv.Quote(importPath)
+		if pkg != path.Base(importPath) {
+			spec = pkg + " " + spec
+		}
+		imports = append(imports, spec)
+	}
+	sort.Strings(imports)
+
+	return imports, nil
+}
+
+// loadRowDeletionPolicy loads the row deletion policy of the table.
+func (tl *TypeLoader) loadRowDeletionPolicy(typeTpl *models.Type, ti *SpannerTable) error {
+	var field *models.Field
+	for _, f := range typeTpl.Fields {
+		if f.ColumnName == ti.RowDeletionPolicyColumn {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return fmt.Errorf("unknown column %s in the row deletion policy of the table %s", ti.RowDeletionPolicyColumn, ti.TableName)
+	}
+
+	// the generated code compares the column with time.Time
+	switch field.Type {
+	case "time.Time", "spanner.NullTime", "*time.Time":
+	default:
+		return fmt.Errorf("custom type %s of the column %s in the row deletion policy of the table %s is not supported", field.Type, field.ColumnName, ti.TableName)
+	}
+
+	filter := tl.config.FilterExpiredRows
+	for _, tbl := range tl.config.Tables {
+		if tbl.Name == ti.TableName && tbl.FilterExpiredRows != nil {
+			filter = *tbl.FilterExpiredRows
+		}
+	}
+
+	typeTpl.RowDeletionPolicy = &models.RowDeletionPolicy{
+		Field:             field,
+		NumDays:           ti.RowDeletionPolicyDays,
+		FilterExpiredRows: filter,
+	}
+
+	return nil
+}
+
+// useCommitTimestamp reports whether spanner.CommitTimestamp is written into
+// the commit timestamp column in mutations.
+func (tl *TypeLoader) useCommitTimestamp(table string, c *SpannerColumn) bool {
+	if !c.AllowCommitTimestamp || tl.config.DisableCommitTimestamp {
+		return false
+	}
+	for _, tbl := range tl.config.Tables {
+		if tbl.Name != table {
+			continue
+		}
+		for _, col := range tbl.Columns {
+			if col.Name == c.ColumnName && col.DisableCommitTimestamp {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// LoadColumns loads schema table/view columns.
+func (tl *TypeLoader) LoadColumns(typeTpl *models.Type) error {
+	var err error
+
+	// load columns
+	columnList, err := tl.source.ColumnList(typeTpl.TableName)
+	if err != nil {
+		return err
+	}
+
+	columnTypes := tl.tableCustomTypes(typeTpl.TableName)
+
+	nullableFields, err := tl.nullableFields(typeTpl.TableName)
+	if err != nil {
+		return err
+	}
+
+	columnGoNames, err := tl.columnGoNames(typeTpl.TableName)
+	if err != nil {
+		return err
+	}
+
+	// validate custom type columns
+	if columnTypes != nil {
+		columnSet := map[string]struct{}{}
+		for _, column := range columnList {
+			columnSet[column.ColumnName] = struct{}{}
+		}
+
+		for k := range columnTypes {
+			if _, ok := columnSet[k]; !ok {
+				return fmt.Errorf("unknown custom type column %s in the table %s", k, typeTpl.TableName)
+			}
+		}
+
+		for k := range columnGoNames {
+			if _, ok := columnSet[k]; !ok {
+				return fmt.Errorf("unknown goName column %s in the table %s", k, typeTpl.TableName)
+			}
+		}
+	}
+
+	// process columns
+	for _, c := range columnList {
+		ignore := false
+
+		for _, ignoreField := range tl.ignoreFields {
+			if ignoreField == c.ColumnName {
+				// Skip adding this field if user has specified they are not
+				// interested.
+				//
+				// This could be useful for fields which are managed by the
+				// database (e.g. automatically updated timestamps) instead of
+				// via Go code.
+				ignore = true
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		parseType := parseSpannerType
+		if tl.Dialect() == models.DialectPostgreSQL {
+			parseType = parsePostgreSQLType
+		}
+		len, nilVal, typ := parseType(c.DataType, !c.NotNull)
+
+		arrayElements, err := tl.arrayElements(typeTpl.TableName, c.ColumnName)
+		if err != nil {
+			return err
+		}
+		eleDataType, array := arrayElementDataType(c.DataType)
+		if array && arrayElements != "" {
+			nilVal, typ = parseNullableArrayType(parseType, eleDataType, arrayElements, !c.NotNull)
+		}
+
+		if !array && !c.NotNull && nullableFields == nullableFieldsPointer {
+			if v, t, ok := parsePointerType(parseType, c.DataType); ok {
+				nilVal, typ = v, t
+			}
+		}
+
+		protoType, err := tl.protoType(c.DataType)
+		if err != nil {
+			return fmt.Errorf("%v for the column %s of the table %s", err, c.ColumnName, typeTpl.TableName)
+		}
+		if protoType != nil {
+			nilVal, typ = parseProtoType(protoType, array, arrayElements != "", !c.NotNull)
+		}
+
+		// set col info
+		f := &models.Field{
+			Name:            internal.SnakeToCamel(c.ColumnName),
+			Len:             len,
+			NullValue:       nilVal,
+			Type:            typ,
+			OriginalType:    typ,
+			ColumnName:      c.ColumnName,
+			SpannerDataType: c.DataType,
+			IsNotNull:       c.NotNull,
+			IsPrimaryKey:    c.IsPrimaryKey,
+			IsGenerated:     c.IsGenerated,
+			IsHidden:        c.IsHidden || isTokenList(c.DataType),
+
+			AllowCommitTimestamp: c.AllowCommitTimestamp,
+			UseCommitTimestamp:   tl.useCommitTimestamp(typeTpl.TableName, c),
+			DefaultExpr:          c.DefaultExpr,
+			GenerationExpr:       c.GenerationExpr,
+			VectorLength:         c.VectorLength,
+			ProtoType:            protoType,
+			Comment:              c.Comment,
+		}
+
+		if goName, ok := columnGoNames[c.ColumnName]; ok {
+			f.Name = goName
+		}
+
+		// set custom type
+		customType, ok := columnTypes[c.ColumnName]
+		if !ok {
+			customType, err = tl.mappedCustomType(typeTpl.TableName, c)
+			if err != nil {
+				return err
+			}
+			ok = customType != ""
+		}
+		if ok {
+			typ, importPath, pkg := parseCustomType(customType)
+			if importPath != "" {
+				tl.customTypes.addImport(pkg, importPath)
+			}
+			if err := tl.validateCustomType(typeTpl, f, customType, typ); err != nil {
+				return err
+			}
+			f.Type = typ
+			f.CustomTypeImportPath = importPath
+		}
+
+		// append col to template fields
+		typeTpl.Fields = append(typeTpl.Fields, f)
+	}
+
+	return nil
+}
+
+// LoadIndexes loads schema index definitions.
+func (tl *TypeLoader) LoadIndexes(tableMap map[string]*models.Type) (map[string]*models.Index, error) {
+	var err error
+
+	ixMap := map[string]*models.Index{}
+	for _, t := range tableMap {
+		// load table indexes
+		err = tl.LoadTableIndexes(t, ixMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	setParentsToIndexes(tableMap, ixMap)
+
+	return ixMap, nil
+}
+
+// LoadTableIndexes loads schema index definitions per table.
+func (tl *TypeLoader) LoadTableIndexes(typeTpl *models.Type, ixMap map[string]*models.Index) error {
+	var err error
+	var priIxLoaded bool
+
+	// load indexes
+	indexList, err := tl.source.IndexList(typeTpl.TableName)
+	if err != nil {
+		return err
+	}
+
+	indexGoNames, err := tl.indexGoNames(typeTpl.TableName)
+	if err != nil {
+		return err
+	}
+
+	// process indexes
+	for _, ix := range indexList {
+		// save whether or not the primary key index was processed
+		priIxLoaded = priIxLoaded || ix.IsPrimary
+
+		// create index template
+		_, indexName := splitName(ix.IndexName)
+		ixTpl := &models.Index{
+			Name:            internal.SnakeToCamel(indexName),
+			Type:            typeTpl,
+			Fields:          []*models.Field{},
+			IndexName:       ix.IndexName,
+			IsUnique:        ix.IsUnique,
+			IsPrimary:       ix.IsPrimary,
+			IsNullFiltered:  ix.IsNullFiltered,
+			ParentTableName: ix.ParentTableName,
+			Comment:         ix.Comment,
+		}
+
+		// load index columns
+		err = tl.LoadIndexColumns(ixTpl)
+		if err != nil {
+			return err
+		}
+
+		// build func name
+		ixTpl.FuncName = tl.buildIndexFuncName(ixTpl)
+		ixTpl.LegacyFuncName = tl.buildLegacyIndexFuncName(ixTpl)
+		if goName, ok := indexGoNames[ix.IndexName]; ok {
+			ixTpl.FuncName = goName
+			ixTpl.LegacyFuncName = goName
+			delete(indexGoNames, ix.IndexName)
+		}
+
+		ixMap[typeTpl.TableName+"_"+ix.IndexName] = ixTpl
+	}
+
+	for k := range indexGoNames {
+		return fmt.Errorf("unknown goName index %s in the table %s", k, typeTpl.TableName)
+	}
+
+	return nil
+}
+
+func (tl *TypeLoader) buildLegacyIndexFuncName(ixTpl *models.Index) string {
+	// build func name
+	funcName := ixTpl.Type.Name
+	if !ixTpl.IsUnique {
+		funcName = tl.inflector.Pluralize(ixTpl.Type.Name)
+	}
+	funcName = funcName + "By"
+
+	// add param names
+	paramNames := make([]string, 0, len(ixTpl.Fields))
+	for _, f := range ixTpl.StoringFields {
+		paramNames = append(paramNames, f.Name)
+	}
+	for _, f := range ixTpl.Fields {
+		paramNames = append(paramNames, f.Name)
+	}
+
+	return funcName + strings.Join(paramNames, "")
+}
+
+func (tl *TypeLoader) buildIndexFuncName(ixTpl *models.Index) string {
+	// build func name
+	funcName := ixTpl.Type.Name
+	if !ixTpl.IsUnique {
+		funcName = tl.inflector.Pluralize(ixTpl.Type.Name)
+	}
+	return funcName + "By" + ixTpl.Name
+}
+
+// LoadIndexColumns loads the index column information.
+func (tl *TypeLoader) LoadIndexColumns(ixTpl *models.Index) error {
+	var err error
+
+	// load index columns
+	indexCols, err := tl.source.IndexColumnList(ixTpl.Type.TableName, ixTpl.IndexName)
+	if err != nil {
+		return err
+	}
+
+	// process index columns
+	for _, ic := range indexCols {
+		var field *models.Field
+
+	fieldLoop:
+		// find field
+		for _, f := range ixTpl.Type.Fields {
+			if f.ColumnName == ic.ColumnName {
+				field = f
+				break fieldLoop
+			}
+		}
+
+		if field == nil {
 			continue
 		}
 
@@ -417,6 +1178,7 @@ func (tl *TypeLoader) LoadIndexColumns(ixTpl *models.Index) error {
 			ixTpl.StoringFields = append(ixTpl.StoringFields, field)
 		} else {
 			ixTpl.Fields = append(ixTpl.Fields, field)
+			ixTpl.KeyDirections = append(ixTpl.KeyDirections, keyDirection(ic.Desc))
 		}
 		if !field.IsNotNull {
 			ixTpl.NullableFields = append(ixTpl.NullableFields, field)
@@ -426,6 +1188,659 @@ func (tl *TypeLoader) LoadIndexColumns(ixTpl *models.Index) error {
 	return nil
 }
 
+// LoadForeignKeys loads foreign keys of the tables and sets them to both the
+// referencing and the referenced types.
+func (tl *TypeLoader) LoadForeignKeys(tableMap map[string]*models.Type) error {
+	tableNames := make([]string, 0, len(tableMap))
+	for name := range tableMap {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	for _, name := range tableNames {
+		typeTpl := tableMap[name]
+
+		fkList, err := tl.source.ForeignKeyList(name)
+		if err != nil {
+			return err
+		}
+
+		for _, fk := range fkList {
+			// the referenced table may be ignored
+			refType, ok := tableMap[fk.ReferencedTableName]
+			if !ok {
+				continue
+			}
+
+			fields, ok := findFields(typeTpl, fk.ColumnNames)
+			if !ok {
+				continue
+			}
+			refFields, ok := findFields(refType, fk.ReferencedColumnNames)
+			if !ok {
+				continue
+			}
+
+			fkTpl := &models.ForeignKey{
+				Name:           fk.ConstraintName,
+				FuncName:       refType.Name,
+				RefFuncName:    tl.inflector.Pluralize(typeTpl.Name),
+				Type:           typeTpl,
+				Fields:         fields,
+				RefType:        refType,
+				RefFields:      refFields,
+				OnDeleteAction: fk.OnDeleteAction,
+			}
+
+			typeTpl.ForeignKeys = append(typeTpl.ForeignKeys, fkTpl)
+			refType.ReferencedBy = append(refType.ReferencedBy, fkTpl)
+		}
+	}
+
+	for _, t := range tableMap {
+		disambiguateForeignKeyFuncNames(t)
+	}
+
+	return nil
+}
+
+// LoadSequences loads sequences and sets them to the fields whose default
+// expressions get the next value of the sequences.
+func (tl *TypeLoader) LoadSequences(tableMap map[string]*models.Type) ([]*models.Sequence, error) {
+	sequenceList, err := tl.source.SequenceList()
+	if err != nil {
+		return nil, err
+	}
+
+	// a default expression may use a sequence in a schema which isn't loaded
+	var sequences []*models.Sequence
+	seqMap := make(map[string]*models.Sequence)
+	for _, si := range sequenceList {
+		seq := &models.Sequence{
+			SequenceName:     si.SequenceName,
+			Kind:             si.SequenceKind,
+			SkipRangeMin:     si.SkipRangeMin,
+			SkipRangeMax:     si.SkipRangeMax,
+			StartWithCounter: si.StartWithCounter,
+		}
+		seqMap[si.SequenceName] = seq
+
+		if schema, _ := splitName(si.SequenceName); tl.loadsSchema(schema) {
+			sequences = append(sequences, seq)
+		}
+	}
+
+	for _, t := range tableMap {
+		for _, f := range t.Fields {
+			name, ok := sequenceName(f.DefaultExpr)
+			if !ok {
+				continue
+			}
+
+			seq, ok := seqMap[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown sequence %s for the column %s of the table %s", name, f.ColumnName, t.TableName)
+			}
+			f.Sequence = seq
+		}
+	}
+
+	return sequences, nil
+}
+
+var (
+	// GET_NEXT_SEQUENCE_VALUE(SEQUENCE s)
+	sequenceRegexp = regexp.MustCompile(`(?i)GET_NEXT_SEQUENCE_VALUE\(\s*SEQUENCE\s+([^\s)]+)\s*\)`)
+	// nextval('s')
+	pgSequenceRegexp = regexp.MustCompile(`(?i)nextval\(\s*'([^']+)'`)
+)
+
+// sequenceName returns the name of the sequence whose next value is got by
+// the default expression.
+func sequenceName(defaultExpr string) (string, bool) {
+	m := sequenceRegexp.FindStringSubmatch(defaultExpr)
+	if m == nil {
+		m = pgSequenceRegexp.FindStringSubmatch(defaultExpr)
+	}
+	if m == nil {
+		return "", false
+	}
+
+	return strings.NewReplacer("`", "", `"`, "").Replace(m[1]), true
+}
+
+// LoadSearchIndexes loads search indexes and the full-text searches on their
+// TOKENLIST fields. Search indexes of a PostgreSQL-dialect database are not
+// loaded because the searches are in GoogleSQL.
+func (tl *TypeLoader) LoadSearchIndexes(tableMap map[string]*models.Type) ([]*models.SearchIndex, error) {
+	if tl.Dialect() == models.DialectPostgreSQL {
+		return nil, nil
+	}
+
+	var searchIndexes []*models.SearchIndex
+	for _, t := range tableMap {
+		searchIndexList, err := tl.source.SearchIndexList(t.TableName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, si := range searchIndexList {
+			_, indexName := splitName(si.IndexName)
+			ix := &models.SearchIndex{
+				Name:      internal.SnakeToCamel(indexName),
+				IndexName: si.IndexName,
+				Type:      t,
+				Comment:   si.Comment,
+			}
+
+			indexCols, err := tl.source.IndexColumnList(t.TableName, si.IndexName)
+			if err != nil {
+				return nil, err
+			}
+			for _, ic := range indexCols {
+				fields, ok := findFields(t, []string{ic.ColumnName})
+				if !ok {
+					continue
+				}
+
+				if ic.Storing {
+					ix.StoringFields = append(ix.StoringFields, fields[0])
+					continue
+				}
+				ix.Fields = append(ix.Fields, fields[0])
+
+				if src, ok := fullTextSourceField(t, fields[0]); ok {
+					ix.Searches = append(ix.Searches, &models.Search{
+						FuncName:    "Search" + tl.inflector.Pluralize(t.Name) + "By" + src.Name,
+						Index:       ix,
+						Field:       fields[0],
+						SourceField: src,
+					})
+				}
+			}
+
+			t.SearchIndexes = append(t.SearchIndexes, ix)
+			searchIndexes = append(searchIndexes, ix)
+		}
+
+		disambiguateSearchFuncNames(t)
+	}
+
+	sort.Slice(searchIndexes, func(i, j int) bool {
+		return lessName(searchIndexes[i].IndexName, searchIndexes[j].IndexName)
+	})
+
+	return searchIndexes, nil
+}
+
+// TOKENIZE_FULLTEXT(Content) or TOKENIZE_FULLTEXT(Content, language_tag => "en-us")
+var fullTextRegexp = regexp.MustCompile("(?i)^\\s*TOKENIZE_FULLTEXT\\(\\s*`?(\\w+)`?\\s*[,)]")
+
+// fullTextSourceField returns the STRING field tokenized into the TOKENLIST
+// field by TOKENIZE_FULLTEXT.
+func fullTextSourceField(t *models.Type, f *models.Field) (*models.Field, bool) {
+	m := fullTextRegexp.FindStringSubmatch(f.GenerationExpr)
+	if m == nil {
+		return nil, false
+	}
+
+	fields, ok := findFields(t, []string{m[1]})
+	if !ok || !strings.HasPrefix(fields[0].SpannerDataType, "STRING") {
+		return nil, false
+	}
+	return fields[0], true
+}
+
+// disambiguateSearchFuncNames adds index names to the func names of the
+// searches when the table has multiple searches on the same field.
+func disambiguateSearchFuncNames(t *models.Type) {
+	count := make(map[string]int)
+	for _, ix := range t.SearchIndexes {
+		for _, s := range ix.Searches {
+			count[s.FuncName]++
+		}
+	}
+
+	for _, ix := range t.SearchIndexes {
+		for _, s := range ix.Searches {
+			if count[s.FuncName] > 1 {
+				s.FuncName += "Using" + ix.Name
+			}
+		}
+	}
+}
+
+// LoadVectorIndexes loads vector indexes and the nearest neighbor searches on
+// the embedding fields. An embedding field without vector indexes gets an
+// exact search by COSINE distance.
+func (tl *TypeLoader) LoadVectorIndexes(tableMap map[string]*models.Type) ([]*models.VectorIndex, error) {
+	var vectorIndexes []*models.VectorIndex
+	for _, t := range tableMap {
+		vectorIndexList, err := tl.source.VectorIndexList(t.TableName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vi := range vectorIndexList {
+			fields, ok := findFields(t, []string{vi.ColumnName})
+			if !ok {
+				continue
+			}
+
+			_, indexName := splitName(vi.IndexName)
+			ix := &models.VectorIndex{
+				Name:         internal.SnakeToCamel(indexName),
+				IndexName:    vi.IndexName,
+				Type:         t,
+				Field:        fields[0],
+				DistanceType: vi.DistanceType,
+				Comment:      vi.Comment,
+			}
+			t.VectorIndexes = append(t.VectorIndexes, ix)
+			vectorIndexes = append(vectorIndexes, ix)
+		}
+
+		// the searches are in GoogleSQL
+		if tl.Dialect() == models.DialectPostgreSQL {
+			continue
+		}
+
+		for _, f := range t.Fields {
+			vectorType, ok := vectorType(f)
+			if !ok {
+				continue
+			}
+
+			var indexed bool
+			for _, ix := range t.VectorIndexes {
+				if ix.Field != f {
+					continue
+				}
+				indexed = true
+
+				distanceFunc, desc, err := distanceFunc(ix.DistanceType)
+				if err != nil {
+					return nil, fmt.Errorf("%v of the vector index %s", err, ix.IndexName)
+				}
+				t.VectorSearches = append(t.VectorSearches, &models.VectorSearch{
+					FuncName:     "FindNearest" + tl.inflector.Pluralize(t.Name) + "By" + f.Name,
+					Type:         t,
+					Field:        f,
+					Index:        ix,
+					VectorType:   vectorType,
+					DistanceFunc: "APPROX_" + distanceFunc,
+					Desc:         desc,
+				})
+			}
+
+			if !indexed {
+				t.VectorSearches = append(t.VectorSearches, &models.VectorSearch{
+					FuncName:     "FindNearest" + tl.inflector.Pluralize(t.Name) + "By" + f.Name,
+					Type:         t,
+					Field:        f,
+					VectorType:   vectorType,
+					DistanceFunc: "COSINE_DISTANCE",
+				})
+			}
+		}
+
+		disambiguateVectorSearchFuncNames(t)
+	}
+
+	sort.Slice(vectorIndexes, func(i, j int) bool {
+		return lessName(vectorIndexes[i].IndexName, vectorIndexes[j].IndexName)
+	})
+
+	return vectorIndexes, nil
+}
+
+// vectorType returns the Go type of the query vector of an embedding field,
+// which is an ARRAY<FLOAT32> or ARRAY<FLOAT64> field with vector_length.
+func vectorType(f *models.Field) (string, bool) {
+	if f.VectorLength == 0 {
+		return "", false
+	}
+
+	switch f.SpannerDataType {
+	case "ARRAY<FLOAT32>":
+		return "[]float32", true
+	case "ARRAY<FLOAT64>":
+		return "[]float64", true
+	}
+	return "", false
+}
+
+// distanceFunc returns the distance function of the distance type and whether
+// the nearest rows have the largest distance values.
+func distanceFunc(distanceType string) (string, bool, error) {
+	switch distanceType {
+	case "COSINE":
+		return "COSINE_DISTANCE", false, nil
+	case "EUCLIDEAN":
+		return "EUCLIDEAN_DISTANCE", false, nil
+	case "DOT_PRODUCT":
+		return "DOT_PRODUCT", true, nil
+	}
+	return "", false, fmt.Errorf("unknown distance type %q", distanceType)
+}
+
+// disambiguateVectorSearchFuncNames adds index names to the func names of the
+// vector searches when the field has multiple vector indexes.
+func disambiguateVectorSearchFuncNames(t *models.Type) {
+	count := make(map[string]int)
+	for _, vs := range t.VectorSearches {
+		count[vs.FuncName]++
+	}
+
+	for _, vs := range t.VectorSearches {
+		if count[vs.FuncName] > 1 {
+			vs.FuncName += "Using" + vs.Index.Name
+		}
+	}
+}
+
+// LoadPropertyGraphs loads the property graphs and the traversals between the
+// nodes of the tables. The elements of the ignored tables are skipped.
+func (tl *TypeLoader) LoadPropertyGraphs(tableMap map[string]*models.Type) ([]*models.PropertyGraph, error) {
+	graphList, err := tl.source.PropertyGraphList()
+	if err != nil {
+		return nil, err
+	}
+
+	var graphs []*models.PropertyGraph
+	for _, pg := range graphList {
+		_, graphName := splitName(pg.GraphName)
+		g := &models.PropertyGraph{
+			Name:      internal.SnakeToCamel(graphName),
+			GraphName: pg.GraphName,
+			Comment:   pg.Comment,
+		}
+
+		nodes := make(map[string]*models.GraphElement)
+		for _, n := range pg.Nodes {
+			elem, ok := graphElement(g, tableMap, n)
+			if !ok {
+				continue
+			}
+			g.Nodes = append(g.Nodes, elem)
+			nodes[elem.Name] = elem
+		}
+		setNodeLabels(g.Nodes)
+
+		for _, e := range pg.Edges {
+			elem, ok := graphElement(g, tableMap, e)
+			if !ok {
+				continue
+			}
+
+			var srcOK, dstOK bool
+			elem.Source, srcOK = nodes[e.SourceNode]
+			elem.SourceFields, ok = findFields(elem.Type, e.SourceKeyColumns)
+			srcOK = srcOK && ok
+			elem.Destination, dstOK = nodes[e.DestinationNode]
+			elem.DestinationFields, ok = findFields(elem.Type, e.DestinationKeyColumns)
+			dstOK = dstOK && ok
+			if !srcOK || !dstOK {
+				continue
+			}
+
+			g.Edges = append(g.Edges, elem)
+		}
+
+		for _, e := range g.Edges {
+			for _, label := range e.Labels {
+				tl.addGraphTraversal(e, label, e.Source, e.Destination, false)
+				tl.addGraphTraversal(e, label, e.Destination, e.Source, true)
+			}
+		}
+
+		graphs = append(graphs, g)
+	}
+
+	for _, t := range tableMap {
+		disambiguateGraphTraversalFuncNames(t)
+	}
+
+	return graphs, nil
+}
+
+// graphElement converts an element of a property graph. It reports false if
+// the table or the key columns are not loaded.
+func graphElement(g *models.PropertyGraph, tableMap map[string]*models.Type, e *SpannerGraphElement) (*models.GraphElement, bool) {
+	t, ok := tableMap[e.TableName]
+	if !ok {
+		return nil, false
+	}
+
+	keyFields, ok := findFields(t, e.KeyColumns)
+	if !ok {
+		return nil, false
+	}
+
+	var properties []*models.Field
+	for _, c := range e.PropertyColumns {
+		if f, ok := findFields(t, []string{c}); ok {
+			properties = append(properties, f[0])
+		}
+	}
+
+	return &models.GraphElement{
+		Name:       e.ElementName,
+		Graph:      g,
+		Type:       t,
+		Labels:     e.Labels,
+		KeyFields:  keyFields,
+		Properties: properties,
+	}, true
+}
+
+// setNodeLabels chooses the label of each node to match it in a query. A
+// label shared with other nodes is used only if the node has no other label.
+func setNodeLabels(nodes []*models.GraphElement) {
+	count := make(map[string]int)
+	for _, n := range nodes {
+		for _, l := range n.Labels {
+			count[l]++
+		}
+	}
+
+	for _, n := range nodes {
+		if len(n.Labels) == 0 {
+			continue
+		}
+		n.Label = n.Labels[0]
+		for _, l := range n.Labels {
+			if count[l] == 1 {
+				n.Label = l
+				break
+			}
+		}
+	}
+}
+
+// addGraphTraversal adds the traversal from a node to the adjacent nodes to
+// the type of from. The traversal is in GoogleSQL and needs the key of from
+// and all columns of to as the properties.
+func (tl *TypeLoader) addGraphTraversal(edge *models.GraphElement, label string, from, to *models.GraphElement, incoming bool) {
+	if tl.Dialect() == models.DialectPostgreSQL || from.Type.IsView {
+		return
+	}
+	if from.Label == "" || to.Label == "" {
+		return
+	}
+	if !hasProperties(from, from.KeyFields) || !hasProperties(to, to.Type.Fields) || !hasProperties(to, to.KeyFields) {
+		return
+	}
+
+	// edges of the same label between the same nodes are matched by the same query
+	for _, gt := range from.Type.GraphTraversals {
+		if gt.Graph == edge.Graph && gt.Label == label && gt.From == from && gt.To == to && gt.Incoming == incoming {
+			return
+		}
+	}
+
+	direction := "Outgoing"
+	if incoming {
+		direction = "Incoming"
+	}
+	from.Type.GraphTraversals = append(from.Type.GraphTraversals, &models.GraphTraversal{
+		FuncName: "Find" + direction + internal.SnakeToCamel(label) + tl.inflector.Pluralize(to.Type.Name),
+		Graph:    edge.Graph,
+		Label:    label,
+		Edge:     edge,
+		From:     from,
+		To:       to,
+		Incoming: incoming,
+	})
+}
+
+// hasProperties reports whether all visible fields are the properties of the
+// element.
+func hasProperties(elem *models.GraphElement, fields []*models.Field) bool {
+	for _, f := range fields {
+		if f.IsHidden {
+			continue
+		}
+
+		var ok bool
+		for _, p := range elem.Properties {
+			if p == f {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// disambiguateGraphTraversalFuncNames adds graph names to the func names of the
+// traversals when the same traversals are in multiple graphs, and then adds
+// element names when the traversals are between the nodes of the same tables
+// in a graph.
+func disambiguateGraphTraversalFuncNames(t *models.Type) {
+	disambiguate := func(suffix func(gt *models.GraphTraversal) string) {
+		count := make(map[string]int)
+		for _, gt := range t.GraphTraversals {
+			count[gt.FuncName]++
+		}
+
+		for _, gt := range t.GraphTraversals {
+			if count[gt.FuncName] > 1 {
+				gt.FuncName += suffix(gt)
+			}
+		}
+	}
+
+	disambiguate(func(gt *models.GraphTraversal) string {
+		return "In" + gt.Graph.Name
+	})
+	disambiguate(func(gt *models.GraphTraversal) string {
+		return "From" + internal.SnakeToCamel(gt.From.Name) + "To" + internal.SnakeToCamel(gt.To.Name)
+	})
+}
+
+// disambiguateForeignKeyFuncNames adds field names to the func names of the
+// foreign keys when the table has multiple foreign keys to the same table.
+func disambiguateForeignKeyFuncNames(t *models.Type) {
+	refCount := make(map[*models.Type]int)
+	for _, fk := range t.ForeignKeys {
+		refCount[fk.RefType]++
+	}
+
+	for _, fk := range t.ForeignKeys {
+		if refCount[fk.RefType] < 2 {
+			continue
+		}
+
+		var names []string
+		for _, f := range fk.Fields {
+			names = append(names, f.Name)
+		}
+		suffix := "By" + strings.Join(names, "")
+		fk.FuncName += suffix
+		fk.RefFuncName += suffix
+	}
+}
+
+// findFields finds the fields of the columns. It returns false if any column
+// isn't found, e.g. the column is ignored.
+func findFields(typeTpl *models.Type, columnNames []string) ([]*models.Field, bool) {
+	fields := make([]*models.Field, 0, len(columnNames))
+	for _, name := range columnNames {
+		var field *models.Field
+		for _, f := range typeTpl.Fields {
+			if f.ColumnName == name {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil, false
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, true
+}
+
+// setParentsToTables links interleaved tables with their parent tables.
+// Children are kept in the order of tableList.
+func setParentsToTables(tableMap map[string]*models.Type, tableList []*SpannerTable) {
+	for _, ti := range tableList {
+		if ti.ParentTableName == "" {
+			continue
+		}
+
+		t, ok := tableMap[ti.TableName]
+		if !ok {
+			continue
+		}
+		t.OnDeleteAction = ti.OnDeleteAction
+
+		// the parent table may be ignored
+		parent, ok := tableMap[ti.ParentTableName]
+		if !ok {
+			continue
+		}
+		t.Parent = parent
+		parent.Children = append(parent.Children, t)
+	}
+}
+
+// setParentsToIndexes links interleaved indexes to the tables they are
+// interleaved in. The keys of an interleaved index start with the primary key
+// of the parent table.
+func setParentsToIndexes(tableMap map[string]*models.Type, ixMap map[string]*models.Index) {
+	for _, ix := range ixMap {
+		parent, ok := tableMap[ix.ParentTableName]
+		if !ok || len(parent.PrimaryKeyFields) > len(ix.Fields) {
+			continue
+		}
+
+		prefix := true
+		for i, f := range parent.PrimaryKeyFields {
+			if ix.Fields[i].ColumnName != f.ColumnName {
+				prefix = false
+				break
+			}
+		}
+		if prefix {
+			ix.Parent = parent
+		}
+	}
+}
+
+// keyDirection returns the direction of a key.
+func keyDirection(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
 func setIndexesToTables(tableMap map[string]*models.Type, ixMap map[string]*models.Index) {
 	indexes := make([]*models.Index, 0, len(ixMap))
 	for _, ix := range ixMap {
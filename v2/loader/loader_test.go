@@ -90,12 +90,14 @@ func TestLoader(t *testing.T) {
 			opt:    Option{},
 			schema: simpleSchema,
 			expectedSchema: &models.Schema{
+				Dialect: models.DialectGoogleSQL,
 				Types: []*models.Type{
 					{
 						Name: "Simple",
 						PrimaryKeyFields: []*models.Field{
 							{ColumnName: "Id"},
 						},
+						PrimaryKeyDirections: []string{"ASC"},
 						Fields: []*models.Field{
 							{
 								Name:            "ID",
@@ -129,7 +131,8 @@ func TestLoader(t *testing.T) {
 								Fields: []*models.Field{
 									{ColumnName: "Value"},
 								},
-								IndexName: "SimpleIndex",
+								KeyDirections: []string{"ASC"},
+								IndexName:     "SimpleIndex",
 							},
 							{
 								Name:           "SimpleIndex2",
@@ -139,8 +142,9 @@ func TestLoader(t *testing.T) {
 									{ColumnName: "Id"},
 									{ColumnName: "Value"},
 								},
-								IndexName: "SimpleIndex2",
-								IsUnique:  true,
+								KeyDirections: []string{"ASC", "ASC"},
+								IndexName:     "SimpleIndex2",
+								IsUnique:      true,
 							},
 						},
 					},
@@ -151,7 +155,8 @@ func TestLoader(t *testing.T) {
 			name:   "Interleave",
 			opt:    Option{},
 			schema: interleaveSchema,
-			expectedSchema: &models.Schema{
+			expectedSchema: interleave(&models.Schema{
+				Dialect: models.DialectGoogleSQL,
 				Types: []*models.Type{
 					{
 						Name: "Interleaved",
@@ -159,6 +164,7 @@ func TestLoader(t *testing.T) {
 							{ColumnName: "Id"},
 							{ColumnName: "InterleavedId"},
 						},
+						PrimaryKeyDirections: []string{"ASC", "ASC"},
 						Fields: []*models.Field{
 							{
 								Name:            "InterleavedID",
@@ -204,7 +210,9 @@ func TestLoader(t *testing.T) {
 									{ColumnName: "Id"},
 									{ColumnName: "Value"},
 								},
-								IndexName: "InterleavedKey",
+								KeyDirections:   []string{"ASC", "ASC"},
+								IndexName:       "InterleavedKey",
+								ParentTableName: "Parent",
 							},
 						},
 					},
@@ -213,6 +221,7 @@ func TestLoader(t *testing.T) {
 						PrimaryKeyFields: []*models.Field{
 							{ColumnName: "Id"},
 						},
+						PrimaryKeyDirections: []string{"ASC"},
 						Fields: []*models.Field{
 							{
 								Name:            "ID",
@@ -229,13 +238,14 @@ func TestLoader(t *testing.T) {
 						TableName: "Parent",
 					},
 				},
-			},
+			}, "Parent", "Interleaved", "NO ACTION"),
 		},
 		{
 			name:   "OutOfOrderPrimaryKey",
 			opt:    Option{},
 			schema: oooSchema,
 			expectedSchema: &models.Schema{
+				Dialect: models.DialectGoogleSQL,
 				Types: []*models.Type{
 					{
 						Name: "OutOfOrderPrimaryKey",
@@ -244,6 +254,7 @@ func TestLoader(t *testing.T) {
 							{ColumnName: "PKey1"},
 							{ColumnName: "PKey3"},
 						},
+						PrimaryKeyDirections: []string{"ASC", "ASC", "ASC"},
 						Fields: []*models.Field{
 							{
 								Name:            "PKey1",
@@ -285,73 +296,1636 @@ func TestLoader(t *testing.T) {
 			},
 		},
 		{
-			name:   "MaxLength",
-			opt:    Option{},
-			schema: maxLengthSchema,
-			expectedSchema: &models.Schema{
-				Types: []*models.Type{
-					{
-						Name: "MaxLength",
-						PrimaryKeyFields: []*models.Field{
-							{ColumnName: "MaxString"},
-						},
-						Fields: []*models.Field{
-							{
-								Name:            "MaxString",
-								Type:            "string",
-								OriginalType:    "string",
-								NullValue:       `""`,
-								Len:             -1,
-								ColumnName:      "MaxString",
-								SpannerDataType: "STRING(MAX)",
-								IsNotNull:       true,
-								IsPrimaryKey:    true,
-							},
-							{
-								Name:            "MaxBytes",
-								Type:            "[]byte",
-								OriginalType:    "[]byte",
-								NullValue:       "nil",
-								Len:             -1,
-								ColumnName:      "MaxBytes",
-								SpannerDataType: "BYTES(MAX)",
-								IsNotNull:       true,
-								IsPrimaryKey:    false,
-							},
-						},
-						TableName: "MaxLengths",
-					},
-				},
+			name:   "MaxLength",
+			opt:    Option{},
+			schema: maxLengthSchema,
+			expectedSchema: &models.Schema{
+				Dialect: models.DialectGoogleSQL,
+				Types: []*models.Type{
+					{
+						Name: "MaxLength",
+						PrimaryKeyFields: []*models.Field{
+							{ColumnName: "MaxString"},
+						},
+						PrimaryKeyDirections: []string{"ASC"},
+						Fields: []*models.Field{
+							{
+								Name:            "MaxString",
+								Type:            "string",
+								OriginalType:    "string",
+								NullValue:       `""`,
+								Len:             -1,
+								ColumnName:      "MaxString",
+								SpannerDataType: "STRING(MAX)",
+								IsNotNull:       true,
+								IsPrimaryKey:    true,
+							},
+							{
+								Name:            "MaxBytes",
+								Type:            "[]byte",
+								OriginalType:    "[]byte",
+								NullValue:       "nil",
+								Len:             -1,
+								ColumnName:      "MaxBytes",
+								SpannerDataType: "BYTES(MAX)",
+								IsNotNull:       true,
+								IsPrimaryKey:    false,
+							},
+						},
+						TableName: "MaxLengths",
+					},
+				},
+			},
+		},
+		{
+			name:   "AlterTableAddFK",
+			opt:    Option{},
+			schema: alterTableAddFKSchema,
+			expectedSchema: &models.Schema{
+				Dialect: models.DialectGoogleSQL,
+				Types:   []*models.Type{},
+			},
+		},
+		{
+			name:   "AlterTableAddConstraintFK",
+			opt:    Option{},
+			schema: alterTableAddConstraintFKSchema,
+			expectedSchema: &models.Schema{
+				Dialect: models.DialectGoogleSQL,
+				Types:   []*models.Type{},
+			},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, tc.schema, tc.opt)
+
+			schema, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			compareSchemas(t, schema, tc.expectedSchema)
+		})
+	}
+}
+
+func TestLoader_Interleave(t *testing.T) {
+	const schema = `
+CREATE TABLE Singers (
+  SingerId INT64 NOT NULL,
+) PRIMARY KEY(SingerId);
+
+CREATE TABLE Albums (
+  SingerId INT64 NOT NULL,
+  AlbumId INT64 NOT NULL,
+) PRIMARY KEY(SingerId, AlbumId),
+INTERLEAVE IN PARENT Singers ON DELETE CASCADE;
+
+CREATE TABLE Songs (
+  SingerId INT64 NOT NULL,
+  AlbumId INT64 NOT NULL,
+  TrackId INT64 NOT NULL,
+) PRIMARY KEY(SingerId, AlbumId, TrackId),
+INTERLEAVE IN PARENT Albums;
+
+CREATE TABLE Concerts (
+  SingerId INT64 NOT NULL,
+  ConcertId INT64 NOT NULL,
+) PRIMARY KEY(SingerId, ConcertId),
+INTERLEAVE IN PARENT Singers ON DELETE NO ACTION;
+`
+
+	type relation struct {
+		parent   string
+		children []string
+		onDelete string
+	}
+
+	table := []struct {
+		name     string
+		opt      Option
+		expected map[string]relation
+	}{
+		{
+			name: "Hierarchy",
+			opt:  Option{},
+			expected: map[string]relation{
+				"Singers":  {children: []string{"Albums", "Concerts"}},
+				"Albums":   {parent: "Singers", children: []string{"Songs"}, onDelete: "CASCADE"},
+				"Songs":    {parent: "Albums", onDelete: "NO ACTION"},
+				"Concerts": {parent: "Singers", onDelete: "NO ACTION"},
+			},
+		},
+		{
+			name: "IgnoreParent",
+			opt:  Option{IgnoreTables: []string{"Singers"}},
+			expected: map[string]relation{
+				"Albums":   {children: []string{"Songs"}, onDelete: "CASCADE"},
+				"Songs":    {parent: "Albums", onDelete: "NO ACTION"},
+				"Concerts": {onDelete: "NO ACTION"},
+			},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, tc.opt)
+
+			schema, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			actual := make(map[string]relation)
+			for _, typ := range schema.Types {
+				var r relation
+				if typ.Parent != nil {
+					r.parent = typ.Parent.TableName
+				}
+				for _, c := range typ.Children {
+					r.children = append(r.children, c.TableName)
+				}
+				r.onDelete = typ.OnDeleteAction
+				actual[typ.TableName] = r
+			}
+
+			if diff := cmp.Diff(actual, tc.expected, cmp.AllowUnexported(relation{})); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoader_CommitTimestamp(t *testing.T) {
+	const schema = `
+CREATE TABLE Posts (
+  PostId INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+  PublishedAt TIMESTAMP,
+) PRIMARY KEY(PostId);
+`
+
+	type field struct {
+		allow bool
+		use   bool
+	}
+
+	table := []struct {
+		name     string
+		cfg      *config.Config
+		expected map[string]field
+	}{
+		{
+			name: "Default",
+			cfg:  &config.Config{},
+			expected: map[string]field{
+				"PostId":      {},
+				"CreatedAt":   {allow: true, use: true},
+				"UpdatedAt":   {allow: true, use: true},
+				"PublishedAt": {},
+			},
+		},
+		{
+			name: "DisableColumn",
+			cfg: &config.Config{
+				Tables: []config.Table{
+					{
+						Name: "Posts",
+						Columns: []config.Column{
+							{Name: "CreatedAt", DisableCommitTimestamp: true},
+						},
+					},
+				},
+			},
+			expected: map[string]field{
+				"PostId":      {},
+				"CreatedAt":   {allow: true},
+				"UpdatedAt":   {allow: true, use: true},
+				"PublishedAt": {},
+			},
+		},
+		{
+			name: "DisableAll",
+			cfg:  &config.Config{DisableCommitTimestamp: true},
+			expected: map[string]field{
+				"PostId":      {},
+				"CreatedAt":   {allow: true},
+				"UpdatedAt":   {allow: true},
+				"PublishedAt": {},
+			},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: tc.cfg})
+
+			schema, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			actual := make(map[string]field)
+			for _, f := range schema.Types[0].Fields {
+				actual[f.ColumnName] = field{allow: f.AllowCommitTimestamp, use: f.UseCommitTimestamp}
+			}
+
+			if diff := cmp.Diff(actual, tc.expected, cmp.AllowUnexported(field{})); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoader_RowDeletionPolicy(t *testing.T) {
+	const schema = `
+CREATE TABLE Sessions (
+  SessionId INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(SessionId),
+ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+`
+
+	filter, noFilter := true, false
+	table := []struct {
+		name           string
+		cfg            *config.Config
+		expectedFilter bool
+	}{
+		{
+			name: "Default",
+			cfg:  &config.Config{},
+		},
+		{
+			name: "FilterTable",
+			cfg: &config.Config{
+				Tables: []config.Table{
+					{Name: "Sessions", FilterExpiredRows: &filter},
+				},
+			},
+			expectedFilter: true,
+		},
+		{
+			name:           "FilterAll",
+			cfg:            &config.Config{FilterExpiredRows: true},
+			expectedFilter: true,
+		},
+		{
+			name: "NoFilterTable",
+			cfg: &config.Config{
+				FilterExpiredRows: true,
+				Tables: []config.Table{
+					{Name: "Sessions", FilterExpiredRows: &noFilter},
+				},
+			},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: tc.cfg})
+
+			schema, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			policy := schema.Types[0].RowDeletionPolicy
+			if policy == nil {
+				t.Fatal("expected the row deletion policy to be loaded")
+			}
+			if policy.Field.ColumnName != "CreatedAt" || policy.NumDays != 30 {
+				t.Errorf("expected OLDER_THAN(CreatedAt, INTERVAL 30 DAY), but got OLDER_THAN(%v, INTERVAL %v DAY)", policy.Field.ColumnName, policy.NumDays)
+			}
+			if policy.FilterExpiredRows != tc.expectedFilter {
+				t.Errorf("expected FilterExpiredRows %v, but got %v", tc.expectedFilter, policy.FilterExpiredRows)
+			}
+		})
+	}
+}
+
+func TestLoader_RowDeletionPolicyCustomType(t *testing.T) {
+	const schema = `
+CREATE TABLE Sessions (
+  SessionId INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(SessionId),
+ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+`
+
+	l := setUpTypeLoader(t, schema, Option{
+		Config: &config.Config{
+			Tables: []config.Table{
+				{Name: "Sessions", Columns: []config.Column{{Name: "CreatedAt", CustomType: "Timestamp"}}},
+			},
+		},
+		PackageDir: "testdata/models",
+	})
+
+	_, err := l.LoadSchema()
+	if expected := "custom type Timestamp of the column CreatedAt in the row deletion policy of the table Sessions is not supported"; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, but got %v", expected, err)
+	}
+}
+
+func TestLoader_RowDeletionPolicyExpiresAt(t *testing.T) {
+	const schema = `
+CREATE TABLE Sessions (
+  SessionId INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+  ExpiresAt TIMESTAMP,
+) PRIMARY KEY(SessionId),
+ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+`
+
+	table := []struct {
+		name        string
+		cfg         *config.Config
+		expectedErr string
+	}{
+		{
+			name:        "Collision",
+			cfg:         &config.Config{},
+			expectedErr: "Go name ExpiresAt of the table Sessions collides with the column ExpiresAt of the table Sessions in the struct Session",
+		},
+		{
+			name: "GoName",
+			cfg: &config.Config{
+				Tables: []config.Table{
+					{Name: "Sessions", Columns: []config.Column{{Name: "ExpiresAt", GoName: "ExpiryTime"}}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: tc.cfg})
+
+			_, err := l.LoadSchema()
+			if tc.expectedErr != "" {
+				if err == nil || err.Error() != tc.expectedErr {
+					t.Errorf("expected error %q, but got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoader_Sequences(t *testing.T) {
+	const schema = `
+CREATE SEQUENCE OrderSequence OPTIONS (sequence_kind = "bit_reversed_positive");
+
+CREATE TABLE Orders (
+  OrderId INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE OrderSequence)),
+  Item STRING(MAX) NOT NULL,
+) PRIMARY KEY(OrderId);
+`
+
+	l := setUpTypeLoader(t, schema, Option{})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	if len(s.Sequences) != 1 {
+		t.Fatalf("expect the number of sequences %v, but got %v", 1, len(s.Sequences))
+	}
+	seq := s.Sequences[0]
+	if seq.SequenceName != "OrderSequence" || seq.Kind != "bit_reversed_positive" {
+		t.Errorf("expected a bit_reversed_positive sequence OrderSequence, but got %v sequence %v", seq.Kind, seq.SequenceName)
+	}
+
+	fields := s.Types[0].Fields
+	if fields[0].Sequence != seq {
+		t.Errorf("expected OrderId to use the sequence, but got %v", fields[0].Sequence)
+	}
+	if fields[1].Sequence != nil {
+		t.Errorf("expected Item not to use a sequence, but got %v", fields[1].Sequence)
+	}
+}
+
+func TestLoader_UnknownSequence(t *testing.T) {
+	const schema = `
+CREATE TABLE Orders (
+  OrderId INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE OrderSequence)),
+) PRIMARY KEY(OrderId);
+`
+
+	l := setUpTypeLoader(t, schema, Option{})
+
+	_, err := l.LoadSchema()
+	if expected := "unknown sequence OrderSequence for the column OrderId of the table Orders"; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, but got %v", expected, err)
+	}
+}
+
+func TestLoader_SearchIndexes(t *testing.T) {
+	const schema = `
+CREATE TABLE Articles (
+  ArticleId INT64 NOT NULL,
+  Title STRING(MAX) NOT NULL,
+  Content STRING(MAX),
+  Tags ARRAY<STRING(MAX)>,
+  Title_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Title)) HIDDEN,
+  Content_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Content, language_tag => "en-us")) HIDDEN,
+  Tags_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Tags)) HIDDEN,
+  Id_Tokens TOKENLIST AS (TOKEN(CAST(ArticleId AS STRING))) HIDDEN,
+) PRIMARY KEY(ArticleId);
+CREATE SEARCH INDEX ArticlesIndex ON Articles(Title_Tokens, Content_Tokens, Tags_Tokens, Id_Tokens);
+CREATE SEARCH INDEX TitlesIndex ON Articles(Title_Tokens) STORING (Content);
+`
+
+	l := setUpTypeLoader(t, schema, Option{Config: &config.Config{}})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	type search struct {
+		FuncName    string
+		Field       string
+		SourceField string
+	}
+	type searchIndex struct {
+		Name          string
+		Fields        []string
+		StoringFields []string
+		Searches      []search
+	}
+	names := func(fields []*models.Field) []string {
+		var names []string
+		for _, f := range fields {
+			names = append(names, f.Name)
+		}
+		return names
+	}
+
+	var got []searchIndex
+	for _, ix := range s.Types[0].SearchIndexes {
+		si := searchIndex{Name: ix.Name, Fields: names(ix.Fields), StoringFields: names(ix.StoringFields)}
+		for _, sr := range ix.Searches {
+			if sr.Index != ix {
+				t.Errorf("Index of %s is %s", sr.FuncName, sr.Index.Name)
+			}
+			si.Searches = append(si.Searches, search{sr.FuncName, sr.Field.Name, sr.SourceField.Name})
+		}
+		got = append(got, si)
+	}
+
+	expected := []searchIndex{
+		{
+			Name:   "ArticlesIndex",
+			Fields: []string{"TitleTokens", "ContentTokens", "TagsTokens", "IDTokens"},
+			Searches: []search{
+				{"SearchArticlesByTitleUsingArticlesIndex", "TitleTokens", "Title"},
+				{"SearchArticlesByContent", "ContentTokens", "Content"},
+			},
+		},
+		{
+			Name:          "TitlesIndex",
+			Fields:        []string{"TitleTokens"},
+			StoringFields: []string{"Content"},
+			Searches: []search{
+				{"SearchArticlesByTitleUsingTitlesIndex", "TitleTokens", "Title"},
+			},
+		},
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+
+	if len(s.SearchIndexes) != 2 || s.SearchIndexes[0].IndexName != "ArticlesIndex" || s.SearchIndexes[1].IndexName != "TitlesIndex" {
+		t.Errorf("unexpected search indexes of the schema: %v", s.SearchIndexes)
+	}
+}
+
+func TestLoader_PropertyGraphs(t *testing.T) {
+	const schema = `
+CREATE TABLE Person (
+  Id INT64 NOT NULL,
+  Name STRING(MAX),
+) PRIMARY KEY(Id);
+CREATE TABLE Account (
+  Id INT64 NOT NULL,
+  NickName STRING(MAX),
+) PRIMARY KEY(Id);
+CREATE TABLE Card (
+  Id INT64 NOT NULL,
+  Number STRING(MAX),
+) PRIMARY KEY(Id);
+CREATE TABLE PersonOwnAccount (
+  Id INT64 NOT NULL,
+  AccountId INT64 NOT NULL,
+) PRIMARY KEY(Id, AccountId);
+CREATE TABLE PersonOwnCard (
+  Id INT64 NOT NULL,
+  CardId INT64 NOT NULL,
+) PRIMARY KEY(Id, CardId);
+CREATE PROPERTY GRAPH FinGraph
+  NODE TABLES (Person, Account, Card PROPERTIES (Id))
+  EDGE TABLES (
+    PersonOwnAccount
+      SOURCE KEY (Id) REFERENCES Person
+      DESTINATION KEY (AccountId) REFERENCES Account
+      LABEL Owns,
+    PersonOwnCard
+      SOURCE KEY (Id) REFERENCES Person
+      DESTINATION KEY (CardId) REFERENCES Card
+      LABEL Owns
+  );
+CREATE PROPERTY GRAPH AuditGraph
+  NODE TABLES (Person, Account)
+  EDGE TABLES (
+    PersonOwnAccount
+      SOURCE KEY (Id) REFERENCES Person
+      DESTINATION KEY (AccountId) REFERENCES Account
+      LABEL Owns
+  );
+`
+
+	l := setUpTypeLoader(t, schema, Option{Config: &config.Config{}})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	type traversal struct {
+		FuncName string
+		Graph    string
+		From     string
+		To       string
+		Incoming bool
+	}
+	got := make(map[string][]traversal)
+	for _, typ := range s.Types {
+		for _, gt := range typ.GraphTraversals {
+			got[typ.Name] = append(got[typ.Name], traversal{gt.FuncName, gt.Graph.GraphName, gt.From.Label, gt.To.Label, gt.Incoming})
+		}
+	}
+
+	// the traversals to Card are not generated because Number is not a property
+	expected := map[string][]traversal{
+		"Account": {
+			{"FindIncomingOwnsPeopleInAuditGraph", "AuditGraph", "Account", "Person", true},
+			{"FindIncomingOwnsPeopleInFinGraph", "FinGraph", "Account", "Person", true},
+		},
+		"Card": {
+			{"FindIncomingOwnsPeople", "FinGraph", "Card", "Person", true},
+		},
+		"Person": {
+			{"FindOutgoingOwnsAccountsInAuditGraph", "AuditGraph", "Person", "Account", false},
+			{"FindOutgoingOwnsAccountsInFinGraph", "FinGraph", "Person", "Account", false},
+		},
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+
+	if len(s.PropertyGraphs) != 2 || s.PropertyGraphs[0].GraphName != "AuditGraph" || len(s.PropertyGraphs[1].Edges) != 2 {
+		t.Errorf("unexpected property graphs of the schema: %v", s.PropertyGraphs)
+	}
+}
+
+func TestLoader_VectorIndexes(t *testing.T) {
+	const schema = `
+CREATE TABLE Documents (
+  DocumentId INT64 NOT NULL,
+  Embedding ARRAY<FLOAT32> OPTIONS (vector_length = 128),
+  TitleEmbedding ARRAY<FLOAT64> OPTIONS (vector_length = 64),
+  Scores ARRAY<FLOAT64>,
+) PRIMARY KEY(DocumentId);
+CREATE VECTOR INDEX DocumentsByCosine ON Documents(Embedding) OPTIONS (distance_type = 'COSINE');
+CREATE VECTOR INDEX DocumentsByDotProduct ON Documents(Embedding) OPTIONS (distance_type = 'DOT_PRODUCT');
+`
+
+	l := setUpTypeLoader(t, schema, Option{Config: &config.Config{}})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	type vectorSearch struct {
+		FuncName     string
+		Field        string
+		Index        string
+		VectorType   string
+		DistanceFunc string
+		Desc         bool
+	}
+	var got []vectorSearch
+	for _, vs := range s.Types[0].VectorSearches {
+		var index string
+		if vs.Index != nil {
+			index = vs.Index.IndexName
+		}
+		got = append(got, vectorSearch{vs.FuncName, vs.Field.Name, index, vs.VectorType, vs.DistanceFunc, vs.Desc})
+	}
+
+	expected := []vectorSearch{
+		{"FindNearestDocumentsByEmbeddingUsingDocumentsByCosine", "Embedding", "DocumentsByCosine", "[]float32", "APPROX_COSINE_DISTANCE", false},
+		{"FindNearestDocumentsByEmbeddingUsingDocumentsByDotProduct", "Embedding", "DocumentsByDotProduct", "[]float32", "APPROX_DOT_PRODUCT", true},
+		{"FindNearestDocumentsByTitleEmbedding", "TitleEmbedding", "", "[]float64", "COSINE_DISTANCE", false},
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+
+	if len(s.VectorIndexes) != 2 || s.VectorIndexes[0].DistanceType != "COSINE" || s.VectorIndexes[0].Field.VectorLength != 128 {
+		t.Errorf("unexpected vector indexes of the schema: %v", s.VectorIndexes)
+	}
+}
+
+func TestLoader_UnknownDistanceType(t *testing.T) {
+	const schema = `
+CREATE TABLE Documents (
+  DocumentId INT64 NOT NULL,
+  Embedding ARRAY<FLOAT32> OPTIONS (vector_length = 128),
+) PRIMARY KEY(DocumentId);
+CREATE VECTOR INDEX DocumentsByEmbedding ON Documents(Embedding) OPTIONS (distance_type = 'MANHATTAN');
+`
+
+	l := setUpTypeLoader(t, schema, Option{Config: &config.Config{}})
+
+	_, err := l.LoadSchema()
+	if expected := `unknown distance type "MANHATTAN" of the vector index DocumentsByEmbedding`; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, but got %v", expected, err)
+	}
+}
+
+func TestLoader_Float32AndTokenList(t *testing.T) {
+	const schema = `
+CREATE TABLE Documents (
+  Id INT64 NOT NULL,
+  Score FLOAT32 NOT NULL,
+  NullableScore FLOAT32,
+  Scores ARRAY<FLOAT32>,
+  Body STRING(MAX),
+  Body_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Body)),
+) PRIMARY KEY(Id);
+`
+
+	l := setUpTypeLoader(t, schema, Option{Config: &config.Config{}})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	type field struct {
+		Name      string
+		Type      string
+		NullValue string
+		IsHidden  bool
+	}
+	var fields []field
+	for _, f := range s.Types[0].Fields {
+		fields = append(fields, field{f.Name, f.Type, f.NullValue, f.IsHidden})
+	}
+	expected := []field{
+		{"ID", "int64", "0", false},
+		{"Score", "float32", "0.0", false},
+		{"NullableScore", "spanner.NullFloat32", "spanner.NullFloat32{}", false},
+		{"Scores", "[]float32", "nil", false},
+		{"Body", "spanner.NullString", "spanner.NullString{}", false},
+		{"BodyTokens", "[]byte", "nil", true},
+	}
+	if diff := cmp.Diff(fields, expected); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+}
+
+func TestLoader_ArrayElements(t *testing.T) {
+	const schema = `
+CREATE TABLE Arrays (
+  Id INT64 NOT NULL,
+  Strings ARRAY<STRING(MAX)> NOT NULL,
+  Int64s ARRAY<INT64>,
+  Dates ARRAY<DATE>,
+  Bytes ARRAY<BYTES(MAX)>,
+  Jsons ARRAY<JSON>,
+) PRIMARY KEY(Id);
+`
+
+	table := []struct {
+		name     string
+		config   *config.Config
+		expected []string
+	}{
+		{
+			name:     "Default",
+			config:   &config.Config{},
+			expected: []string{"int64", "[]string", "[]int64", "[]civil.Date", "[][]byte", "[]spanner.NullJSON"},
+		},
+		{
+			name:     "Nullable",
+			config:   &config.Config{ArrayElements: "nullable"},
+			expected: []string{"int64", "[]spanner.NullString", "[]spanner.NullInt64", "[]spanner.NullDate", "[][]byte", "[]spanner.NullJSON"},
+		},
+		{
+			name:     "Pointer",
+			config:   &config.Config{ArrayElements: "pointer"},
+			expected: []string{"int64", "[]*string", "[]*int64", "[]*civil.Date", "[][]byte", "[]spanner.NullJSON"},
+		},
+		{
+			name: "Column",
+			config: &config.Config{
+				ArrayElements: "nullable",
+				Tables: []config.Table{
+					{Name: "Arrays", Columns: []config.Column{{Name: "Int64s", ArrayElements: "pointer"}}},
+				},
+			},
+			expected: []string{"int64", "[]spanner.NullString", "[]*int64", "[]spanner.NullDate", "[][]byte", "[]spanner.NullJSON"},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: tc.config})
+
+			s, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			var types []string
+			for _, f := range s.Types[0].Fields {
+				types = append(types, f.Type)
+			}
+			if diff := cmp.Diff(types, tc.expected); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+
+			// NOT NULL array is not nil
+			if f := s.Types[0].Fields[1]; f.NullValue != f.Type+"{}" {
+				t.Errorf("expected NULL value of %v to be %v{}, but got %v", f.Name, f.Type, f.NullValue)
+			}
+		})
+	}
+}
+
+func TestLoader_NullableFields(t *testing.T) {
+	const schema = `
+CREATE TABLE Nullables (
+  Id INT64 NOT NULL,
+  Name STRING(MAX),
+  Count INT64,
+  Price NUMERIC,
+  Birthday DATE,
+  UpdatedAt TIMESTAMP,
+  Data BYTES(MAX),
+  Meta JSON,
+  Enabled BOOL,
+  Tags ARRAY<STRING(MAX)>,
+) PRIMARY KEY(Id);
+`
+
+	table := []struct {
+		name     string
+		config   *config.Config
+		expected []string
+	}{
+		{
+			name:     "Default",
+			config:   &config.Config{},
+			expected: []string{"int64", "spanner.NullString", "spanner.NullInt64", "spanner.NullNumeric", "spanner.NullDate", "spanner.NullTime", "[]byte", "spanner.NullJSON", "spanner.NullBool", "[]string"},
+		},
+		{
+			name:     "Pointer",
+			config:   &config.Config{NullableFields: "pointer"},
+			expected: []string{"int64", "*string", "*int64", "*big.Rat", "*civil.Date", "*time.Time", "[]byte", "spanner.NullJSON", "*bool", "[]string"},
+		},
+		{
+			name: "Table",
+			config: &config.Config{
+				NullableFields: "pointer",
+				Tables:         []config.Table{{Name: "Nullables", NullableFields: "nullable"}},
+			},
+			expected: []string{"int64", "spanner.NullString", "spanner.NullInt64", "spanner.NullNumeric", "spanner.NullDate", "spanner.NullTime", "[]byte", "spanner.NullJSON", "spanner.NullBool", "[]string"},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: tc.config})
+
+			s, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			var types []string
+			for _, f := range s.Types[0].Fields {
+				types = append(types, f.Type)
+			}
+			if diff := cmp.Diff(types, tc.expected); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+
+			// pointers are NULL if nil
+			if f := s.Types[0].Fields[1]; f.Type == "*string" && f.NullValue != "nil" {
+				t.Errorf("expected NULL value of %v to be nil, but got %v", f.Name, f.NullValue)
+			}
+		})
+	}
+}
+
+func TestLoader_UnknownNullableFields(t *testing.T) {
+	l := setUpTypeLoader(t, simpleSchema, Option{Config: &config.Config{NullableFields: "ptr"}})
+
+	_, err := l.LoadSchema()
+	if expected := `unknown nullableFields "ptr" for the table Simple`; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, but got %v", expected, err)
+	}
+}
+
+func TestLoader_TypeMappings(t *testing.T) {
+	const schema = `
+CREATE TABLE Users (
+  user_id INT64 NOT NULL,
+  name STRING(MAX),
+  email STRING(256) NOT NULL,
+  group_id INT64,
+  created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY(user_id);
+`
+
+	nullable := true
+
+	table := []struct {
+		name     string
+		config   *config.Config
+		expected []string
+	}{
+		{
+			name:     "Default",
+			config:   &config.Config{},
+			expected: []string{"int64", "spanner.NullString", "string", "spanner.NullInt64", "time.Time"},
+		},
+		{
+			name: "Mappings",
+			config: &config.Config{
+				TypeMappings: []config.TypeMapping{
+					{SpannerType: "STRING", Nullable: &nullable, CustomType: "*string"},
+					{SpannerType: "INT64", Column: "*_id", CustomType: "ID"},
+					{Table: "User*", Column: "email", CustomType: "Value"},
+				},
+			},
+			expected: []string{"ID", "*string", "Value", "ID", "time.Time"},
+		},
+		{
+			name: "First mapping",
+			config: &config.Config{
+				TypeMappings: []config.TypeMapping{
+					{Column: "*_id", CustomType: "uint64"},
+					{SpannerType: "INT64", CustomType: "ID"},
+					{SpannerType: "STRING", CustomType: "Value"},
+				},
+			},
+			expected: []string{"uint64", "Value", "Value", "uint64", "time.Time"},
+		},
+		{
+			name: "Column",
+			config: &config.Config{
+				Tables: []config.Table{
+					{Name: "Users", Columns: []config.Column{{Name: "group_id", CustomType: "uint64"}}},
+				},
+				TypeMappings: []config.TypeMapping{
+					{SpannerType: "INT64", Column: "*_id", CustomType: "ID"},
+				},
+			},
+			expected: []string{"ID", "spanner.NullString", "string", "uint64", "time.Time"},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: tc.config, PackageDir: "testdata/models"})
+
+			s, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			var types []string
+			for _, f := range s.Types[0].Fields {
+				types = append(types, f.Type)
+			}
+			if diff := cmp.Diff(types, tc.expected); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoader_InvalidTypeMappings(t *testing.T) {
+	const schema = `
+CREATE TABLE Users (
+  user_id INT64 NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY(user_id);
+`
+
+	table := []struct {
+		name        string
+		mappings    []config.TypeMapping
+		expectedErr string
+	}{
+		{
+			name:        "Invalid pattern",
+			mappings:    []config.TypeMapping{{Column: "[", CustomType: "ID"}},
+			expectedErr: `invalid column pattern "[" in the type mapping 0: syntax error in pattern`,
+		},
+		{
+			name:        "No custom type",
+			mappings:    []config.TypeMapping{{SpannerType: "INT64"}},
+			expectedErr: "no custom type in the type mapping 0",
+		},
+		{
+			name:        "Not convertible",
+			mappings:    []config.TypeMapping{{Column: "*_at", CustomType: "ID"}},
+			expectedErr: "custom type ID of the column created_at in the table Users: not convertible to time.Time and doesn't implement spanner.Encoder and spanner.Decoder",
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: &config.Config{TypeMappings: tc.mappings}, PackageDir: "testdata/models"})
+
+			_, err := l.LoadSchema()
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, but got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestLoader_GoNames(t *testing.T) {
+	const schema = `
+CREATE TABLE usr_tbl (
+  usr_id INT64 NOT NULL,
+  usr_nm STRING(MAX) NOT NULL,
+) PRIMARY KEY(usr_id);
+CREATE INDEX usr_tbl_by_nm ON usr_tbl(usr_nm);
+`
+
+	l := setUpTypeLoader(t, schema, Option{Config: &config.Config{
+		Tables: []config.Table{
+			{
+				Name:    "usr_tbl",
+				GoName:  "User",
+				Columns: []config.Column{{Name: "usr_id", GoName: "ID"}, {Name: "usr_nm", GoName: "Name"}},
+				Indexes: []config.Index{{Name: "usr_tbl_by_nm", GoName: "UsersByName"}},
+			},
+		},
+	}})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	typ := s.Types[0]
+	if typ.Name != "User" {
+		t.Errorf("expected type name User, but got %v", typ.Name)
+	}
+
+	var names []string
+	for _, f := range typ.Fields {
+		names = append(names, f.Name)
+	}
+	if diff := cmp.Diff(names, []string{"ID", "Name"}); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+
+	ix := typ.Indexes[0]
+	if ix.FuncName != "UsersByName" || ix.LegacyFuncName != "UsersByName" {
+		t.Errorf("expected func names UsersByName, but got %v and %v", ix.FuncName, ix.LegacyFuncName)
+	}
+}
+
+func TestLoader_InvalidGoNames(t *testing.T) {
+	const schema = `
+CREATE TABLE usr_tbl (
+  usr_id INT64 NOT NULL,
+  usr_nm STRING(MAX) NOT NULL,
+) PRIMARY KEY(usr_id);
+CREATE INDEX usr_tbl_by_nm ON usr_tbl(usr_nm);
+CREATE INDEX usr_tbl_by_nm2 ON usr_tbl(usr_nm, usr_id);
+CREATE TABLE Users (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE usr_items (
+  usr_id INT64 NOT NULL,
+  item_id INT64 NOT NULL,
+  item_nm STRING(MAX) NOT NULL,
+) PRIMARY KEY(usr_id, item_id), INTERLEAVE IN PARENT usr_tbl;
+CREATE INDEX usr_items_by_nm ON usr_items(usr_id, item_nm), INTERLEAVE IN usr_tbl;
+CREATE TABLE Items (
+  Id INT64 NOT NULL,
+  UsrId INT64,
+  CONSTRAINT FK_ItemsUsr FOREIGN KEY (UsrId) REFERENCES usr_tbl (usr_id),
+) PRIMARY KEY(Id);
+`
+
+	table := []struct {
+		name        string
+		table       config.Table
+		expectedErr string
+	}{
+		{
+			name:        "Invalid table name",
+			table:       config.Table{Name: "usr_tbl", GoName: "user"},
+			expectedErr: `invalid goName "user" of the table usr_tbl`,
+		},
+		{
+			name:        "Invalid column name",
+			table:       config.Table{Name: "usr_tbl", Columns: []config.Column{{Name: "usr_nm", GoName: "User Name"}}},
+			expectedErr: `invalid goName "User Name" of the column usr_nm in the table usr_tbl`,
+		},
+		{
+			name:        "Invalid index name",
+			table:       config.Table{Name: "usr_tbl", Indexes: []config.Index{{Name: "usr_tbl_by_nm"}}},
+			expectedErr: `invalid goName "" of the index usr_tbl_by_nm in the table usr_tbl`,
+		},
+		{
+			name:        "Unknown column",
+			table:       config.Table{Name: "usr_tbl", Columns: []config.Column{{Name: "nm", GoName: "Name"}}},
+			expectedErr: "unknown goName column nm in the table usr_tbl",
+		},
+		{
+			name:        "Unknown index",
+			table:       config.Table{Name: "usr_tbl", Indexes: []config.Index{{Name: "usr_tbl_by_id", GoName: "UsersByID"}}},
+			expectedErr: "unknown goName index usr_tbl_by_id in the table usr_tbl",
+		},
+		{
+			name:        "Table collision",
+			table:       config.Table{Name: "usr_tbl", GoName: "User"},
+			expectedErr: "Go name User of the table usr_tbl collides with the table Users",
+		},
+		{
+			name:        "Column collision",
+			table:       config.Table{Name: "usr_tbl", Columns: []config.Column{{Name: "usr_nm", GoName: "UsrID"}}},
+			expectedErr: "Go name UsrID of the column usr_nm of the table usr_tbl collides with the column usr_id of the table usr_tbl in the struct UsrTbl",
+		},
+		{
+			name:        "Column and method collision",
+			table:       config.Table{Name: "usr_tbl", Columns: []config.Column{{Name: "usr_nm", GoName: "Insert"}}},
+			expectedErr: "Go name Insert of the table usr_tbl collides with the column usr_nm of the table usr_tbl in the struct UsrTbl",
+		},
+		{
+			name: "Table and index collision",
+			table: config.Table{Name: "usr_tbl", GoName: "Client", Indexes: []config.Index{
+				{Name: "usr_tbl_by_nm", GoName: "Client"},
+			}},
+			expectedErr: "Go name FindClient of the index usr_tbl_by_nm of the table usr_tbl collides with the table usr_tbl",
+		},
+		{
+			name: "Method collision",
+			table: config.Table{Name: "usr_items", Indexes: []config.Index{
+				{Name: "usr_items_by_nm", GoName: "Items"},
+			}},
+			expectedErr: "Go name FindItems of the foreign key FK_ItemsUsr of the table Items collides with the index usr_items_by_nm of the table usr_items in the struct UsrTbl",
+		},
+		{
+			name: "Index collision",
+			table: config.Table{Name: "usr_tbl", Indexes: []config.Index{
+				{Name: "usr_tbl_by_nm", GoName: "UsersByName"},
+				{Name: "usr_tbl_by_nm2", GoName: "UsersByName"},
+			}},
+			expectedErr: "Go name FindUsersByName of the index usr_tbl_by_nm2 of the table usr_tbl collides with the index usr_tbl_by_nm of the table usr_tbl",
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, Option{Config: &config.Config{Tables: []config.Table{tc.table}}})
+
+			_, err := l.LoadSchema()
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, but got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestLoader_UnknownArrayElements(t *testing.T) {
+	const schema = `
+CREATE TABLE Arrays (
+  Id INT64 NOT NULL,
+  Strings ARRAY<STRING(MAX)>,
+) PRIMARY KEY(Id);
+`
+
+	l := setUpTypeLoader(t, schema, Option{Config: &config.Config{ArrayElements: "null"}})
+
+	_, err := l.LoadSchema()
+	if expected := `unknown arrayElements "null" for the column Id of the table Arrays`; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, but got %v", expected, err)
+	}
+}
+
+func TestLoader_ProtoTypes(t *testing.T) {
+	const schema = `
+CREATE PROTO BUNDLE (examples.shipping.Order, examples.shipping.Status);
+
+CREATE TABLE Shipments (
+  ShipmentId INT64 NOT NULL,
+  Detail examples.shipping.Order NOT NULL,
+  LastDetail examples.shipping.Order,
+  Status examples.shipping.Status NOT NULL,
+  LastStatus examples.shipping.Status,
+) PRIMARY KEY(ShipmentId);
+`
+
+	l := setUpTypeLoader(t, schema, Option{
+		Config: &config.Config{
+			ProtoTypes: []config.ProtoType{
+				{Name: "examples.shipping.Order", GoType: "shippingpb.Order", ImportPath: "example.com/shipping/v1"},
+				{Name: "examples.shipping.Status", GoType: "shippingpb.Status", ImportPath: "example.com/shipping/v1", Enum: true},
+			},
+		},
+	})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	expected := []struct {
+		typ       string
+		nullValue string
+		kind      string
+	}{
+		{typ: "int64", nullValue: "0"},
+		{typ: "*shippingpb.Order", nullValue: "nil", kind: "PROTO"},
+		{typ: "*shippingpb.Order", nullValue: "nil", kind: "PROTO"},
+		{typ: "shippingpb.Status", nullValue: "0", kind: "ENUM"},
+		{typ: "*shippingpb.Status", nullValue: "nil", kind: "ENUM"},
+	}
+
+	fields := s.Types[0].Fields
+	for i, f := range fields {
+		if f.Type != expected[i].typ || f.NullValue != expected[i].nullValue {
+			t.Errorf("expected %v to be %v with NULL %v, but got %v with NULL %v", f.Name, expected[i].typ, expected[i].nullValue, f.Type, f.NullValue)
+		}
+
+		var kind string
+		if f.ProtoType != nil {
+			kind = f.ProtoType.Kind
+		}
+		if kind != expected[i].kind {
+			t.Errorf("expected %v to be a proto type of %q, but got %q", f.Name, expected[i].kind, kind)
+		}
+	}
+
+	if len(s.ProtoTypes) != 2 {
+		t.Errorf("expect the number of proto types %v, but got %v", 2, len(s.ProtoTypes))
+	}
+	if diff := cmp.Diff(s.Imports, []string{`shippingpb "example.com/shipping/v1"`}); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+}
+
+func TestLoader_UnknownProtoType(t *testing.T) {
+	const schema = `
+CREATE PROTO BUNDLE (examples.shipping.Order);
+
+CREATE TABLE Shipments (
+  ShipmentId INT64 NOT NULL,
+  Detail examples.shipping.Order NOT NULL,
+) PRIMARY KEY(ShipmentId);
+`
+
+	l := setUpTypeLoader(t, schema, Option{})
+
+	_, err := l.LoadSchema()
+	if expected := "unknown proto type examples.shipping.Order for the column Detail of the table Shipments"; err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, but got %v", expected, err)
+	}
+}
+
+func TestProtoType(t *testing.T) {
+	l := NewTypeLoader(nil, nil, Option{
+		Config: &config.Config{
+			ProtoTypes: []config.ProtoType{
+				{Name: "examples.shipping.Status", GoType: "shippingpb.Status", Enum: true},
+			},
+		},
+	})
+
+	table := []struct {
+		dataType      string
+		expectedKind  string
+		expectedError string
+	}{
+		{dataType: "STRING(MAX)"},
+		{dataType: "ARRAY<INT64>"},
+		{dataType: "examples.shipping.Status", expectedKind: "ENUM"},
+		{dataType: "ENUM<examples.shipping.Status>", expectedKind: "ENUM"},
+		{dataType: "ARRAY<ENUM<examples.shipping.Status>>", expectedKind: "ENUM"},
+		{dataType: "ARRAY<examples.shipping.`Status`>", expectedKind: "ENUM"},
+		{dataType: "PROTO<examples.shipping.Status>", expectedError: "proto type examples.shipping.Status is PROTO but configured as ENUM"},
+		{dataType: "PROTO<Order>", expectedError: "unknown proto type Order"},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.dataType, func(t *testing.T) {
+			pt, err := l.protoType(tc.dataType)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Errorf("expected error %q, but got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var kind string
+			if pt != nil {
+				kind = pt.Kind
+			}
+			if kind != tc.expectedKind {
+				t.Errorf("expected kind %q, but got %q", tc.expectedKind, kind)
+			}
+		})
+	}
+}
+
+func TestParseProtoType(t *testing.T) {
+	message := &models.ProtoType{Name: "examples.shipping.Order", Kind: "PROTO", GoType: "shippingpb.Order"}
+	enum := &models.ProtoType{Name: "examples.shipping.Status", Kind: "ENUM", GoType: "shippingpb.Status"}
+
+	table := []struct {
+		name             string
+		pt               *models.ProtoType
+		array            bool
+		nullableElements bool
+		nullable         bool
+		typ              string
+		nullValue        string
+	}{
+		{name: "Message", pt: message, typ: "*shippingpb.Order", nullValue: "nil"},
+		{name: "NullableMessage", pt: message, nullable: true, typ: "*shippingpb.Order", nullValue: "nil"},
+		{name: "Enum", pt: enum, typ: "shippingpb.Status", nullValue: "0"},
+		{name: "NullableEnum", pt: enum, nullable: true, typ: "*shippingpb.Status", nullValue: "nil"},
+		{name: "MessageArray", pt: message, array: true, nullable: true, typ: "[]*shippingpb.Order", nullValue: "nil"},
+		{name: "EnumArray", pt: enum, array: true, typ: "[]shippingpb.Status", nullValue: "[]shippingpb.Status{}"},
+		{name: "NullableEnumArray", pt: enum, array: true, nullableElements: true, typ: "[]*shippingpb.Status", nullValue: "[]*shippingpb.Status{}"},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			nullValue, typ := parseProtoType(tc.pt, tc.array, tc.nullableElements, tc.nullable)
+			if typ != tc.typ || nullValue != tc.nullValue {
+				t.Errorf("expected %v with NULL %v, but got %v with NULL %v", tc.typ, tc.nullValue, typ, nullValue)
+			}
+		})
+	}
+}
+
+func TestLoader_Views(t *testing.T) {
+	const schema = `
+CREATE TABLE Singers (
+  SingerId INT64 NOT NULL,
+  Name STRING(MAX) NOT NULL,
+) PRIMARY KEY(SingerId);
+
+CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT SingerId, Name FROM Singers;
+`
+
+	l := setUpTypeLoader(t, schema, Option{})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	if len(s.Types) != 2 {
+		t.Fatalf("expect the number of types %v, but got %v", 2, len(s.Types))
+	}
+
+	// types are sorted by name
+	view := s.Types[1]
+	if view.Name != "SingerName" || !view.IsView {
+		t.Errorf("expected SingerName to be a view, but got %v (IsView=%v)", view.Name, view.IsView)
+	}
+	if len(view.PrimaryKeyFields) != 0 {
+		t.Errorf("expected a view to have no primary key, but got %v", view.PrimaryKeyFields)
+	}
+	for _, f := range view.Fields {
+		if f.IsNotNull {
+			t.Errorf("expected the column %v of a view to be nullable", f.ColumnName)
+		}
+	}
+
+	if table := s.Types[0]; table.Name != "Singer" || table.IsView {
+		t.Errorf("expected Singer to be a table, but got %v (IsView=%v)", table.Name, table.IsView)
+	}
+}
+
+func TestLoader_IndexAttributes(t *testing.T) {
+	const schema = `
+CREATE TABLE Singers (
+  SingerId INT64 NOT NULL,
+) PRIMARY KEY(SingerId DESC);
+
+CREATE TABLE Albums (
+  SingerId INT64 NOT NULL,
+  AlbumId INT64 NOT NULL,
+  Title STRING(MAX),
+) PRIMARY KEY(SingerId DESC, AlbumId),
+INTERLEAVE IN PARENT Singers;
+
+CREATE NULL_FILTERED INDEX AlbumsByTitle ON Albums(SingerId, Title DESC), INTERLEAVE IN Singers;
+CREATE INDEX AlbumsByTitleOnly ON Albums(Title);
+`
+
+	l := setUpTypeLoader(t, schema, Option{})
+
+	s, err := l.LoadSchema()
+	if err != nil {
+		t.Fatalf("failed to load schema: %v", err)
+	}
+
+	// types are sorted by name
+	albums, singers := s.Types[0], s.Types[1]
+	if diff := cmp.Diff([]string{"DESC", "ASC"}, albums.PrimaryKeyDirections); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+
+	if len(albums.Indexes) != 2 {
+		t.Fatalf("expect the number of indexes %v, but got %v", 2, len(albums.Indexes))
+	}
+
+	ix := albums.Indexes[0]
+	if ix.IndexName != "AlbumsByTitle" || !ix.IsNullFiltered {
+		t.Errorf("expected AlbumsByTitle to be null filtered, but got %v (IsNullFiltered=%v)", ix.IndexName, ix.IsNullFiltered)
+	}
+	if diff := cmp.Diff([]string{"ASC", "DESC"}, ix.KeyDirections); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+	if ix.ParentTableName != "Singers" || ix.Parent != singers {
+		t.Errorf("expected AlbumsByTitle to be interleaved in Singers, but got %v", ix.ParentTableName)
+	}
+
+	if ix := albums.Indexes[1]; ix.IsNullFiltered || ix.Parent != nil {
+		t.Errorf("expected AlbumsByTitleOnly not to be null filtered nor interleaved")
+	}
+}
+
+func TestLoader_NamedSchemas(t *testing.T) {
+	const schema = `
+CREATE SCHEMA sales;
+CREATE SCHEMA audit;
+
+CREATE TABLE Orders (
+  OrderId INT64 NOT NULL,
+) PRIMARY KEY(OrderId);
+
+CREATE TABLE sales.Orders (
+  OrderId INT64 NOT NULL,
+  Status STRING(MAX) NOT NULL,
+) PRIMARY KEY(OrderId);
+
+CREATE INDEX sales.OrdersByStatus ON sales.Orders(Status);
+
+CREATE TABLE audit.Logs (
+  LogId INT64 NOT NULL,
+) PRIMARY KEY(LogId);
+`
+
+	type typ struct {
+		name    string
+		schema  string
+		indexes []string
+	}
+
+	table := []struct {
+		name     string
+		opt      Option
+		expected map[string]typ
+	}{
+		{
+			name: "DefaultSchemaOnly",
+			opt:  Option{},
+			expected: map[string]typ{
+				"Orders": {name: "Order"},
+			},
+		},
+		{
+			name: "Schemas",
+			opt:  Option{Schemas: []string{"sales"}},
+			expected: map[string]typ{
+				"Orders":       {name: "Order"},
+				"sales.Orders": {name: "SalesOrder", schema: "sales", indexes: []string{"SalesOrdersByOrdersByStatus"}},
 			},
 		},
 		{
-			name:   "AlterTableAddFK",
-			opt:    Option{},
-			schema: alterTableAddFKSchema,
-			expectedSchema: &models.Schema{
-				Types: []*models.Type{},
+			name: "AllSchemas",
+			opt:  Option{Schemas: []string{"*"}},
+			expected: map[string]typ{
+				"Orders":       {name: "Order"},
+				"sales.Orders": {name: "SalesOrder", schema: "sales", indexes: []string{"SalesOrdersByOrdersByStatus"}},
+				"audit.Logs":   {name: "AuditLog", schema: "audit"},
+			},
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			l := setUpTypeLoader(t, schema, tc.opt)
+
+			schema, err := l.LoadSchema()
+			if err != nil {
+				t.Fatalf("failed to load schema: %v", err)
+			}
+
+			actual := make(map[string]typ)
+			for _, t := range schema.Types {
+				r := typ{name: t.Name, schema: t.SchemaName}
+				for _, ix := range t.Indexes {
+					r.indexes = append(r.indexes, ix.FuncName)
+				}
+				actual[t.TableName] = r
+			}
+
+			if diff := cmp.Diff(actual, tc.expected, cmp.AllowUnexported(typ{})); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoader_ForeignKeys(t *testing.T) {
+	const schema = `
+CREATE TABLE Customers (
+  CustomerId INT64 NOT NULL,
+) PRIMARY KEY(CustomerId);
+
+CREATE TABLE Products (
+  ProductId INT64 NOT NULL,
+) PRIMARY KEY(ProductId);
+
+CREATE TABLE Orders (
+  OrderId INT64 NOT NULL,
+  CustomerId INT64 NOT NULL,
+  ReferrerId INT64,
+  ProductId INT64 NOT NULL,
+  CONSTRAINT FK_OrdersCustomer FOREIGN KEY (CustomerId) REFERENCES Customers (CustomerId) ON DELETE CASCADE,
+  CONSTRAINT FK_OrdersReferrer FOREIGN KEY (ReferrerId) REFERENCES Customers (CustomerId),
+) PRIMARY KEY(OrderId);
+
+ALTER TABLE Orders ADD CONSTRAINT FK_OrdersProduct FOREIGN KEY (ProductId) REFERENCES Products (ProductId);
+`
+
+	type foreignKey struct {
+		Name           string
+		FuncName       string
+		RefFuncName    string
+		Table          string
+		Columns        []string
+		RefTable       string
+		RefColumns     []string
+		OnDeleteAction string
+	}
+
+	convert := func(fks []*models.ForeignKey) []foreignKey {
+		var res []foreignKey
+		for _, fk := range fks {
+			v := foreignKey{
+				Name:           fk.Name,
+				FuncName:       fk.FuncName,
+				RefFuncName:    fk.RefFuncName,
+				Table:          fk.Type.TableName,
+				RefTable:       fk.RefType.TableName,
+				OnDeleteAction: fk.OnDeleteAction,
+			}
+			for _, f := range fk.Fields {
+				v.Columns = append(v.Columns, f.ColumnName)
+			}
+			for _, f := range fk.RefFields {
+				v.RefColumns = append(v.RefColumns, f.ColumnName)
+			}
+			res = append(res, v)
+		}
+		return res
+	}
+
+	customerFK := foreignKey{
+		Name:           "FK_OrdersCustomer",
+		FuncName:       "CustomerByCustomerID",
+		RefFuncName:    "OrdersByCustomerID",
+		Table:          "Orders",
+		Columns:        []string{"CustomerId"},
+		RefTable:       "Customers",
+		RefColumns:     []string{"CustomerId"},
+		OnDeleteAction: "CASCADE",
+	}
+	referrerFK := foreignKey{
+		Name:           "FK_OrdersReferrer",
+		FuncName:       "CustomerByReferrerID",
+		RefFuncName:    "OrdersByReferrerID",
+		Table:          "Orders",
+		Columns:        []string{"ReferrerId"},
+		RefTable:       "Customers",
+		RefColumns:     []string{"CustomerId"},
+		OnDeleteAction: "NO ACTION",
+	}
+	productFK := foreignKey{
+		Name:           "FK_OrdersProduct",
+		FuncName:       "Product",
+		RefFuncName:    "Orders",
+		Table:          "Orders",
+		Columns:        []string{"ProductId"},
+		RefTable:       "Products",
+		RefColumns:     []string{"ProductId"},
+		OnDeleteAction: "NO ACTION",
+	}
+
+	table := []struct {
+		name                 string
+		opt                  Option
+		expectedForeignKeys  map[string][]foreignKey
+		expectedReferencedBy map[string][]foreignKey
+	}{
+		{
+			name: "Simple",
+			opt:  Option{},
+			expectedForeignKeys: map[string][]foreignKey{
+				"Orders": {customerFK, productFK, referrerFK},
+			},
+			expectedReferencedBy: map[string][]foreignKey{
+				"Customers": {customerFK, referrerFK},
+				"Products":  {productFK},
 			},
 		},
 		{
-			name:   "AlterTableAddConstraintFK",
-			opt:    Option{},
-			schema: alterTableAddConstraintFKSchema,
-			expectedSchema: &models.Schema{
-				Types: []*models.Type{},
+			name: "IgnoreReferencedTable",
+			opt:  Option{IgnoreTables: []string{"Products"}},
+			expectedForeignKeys: map[string][]foreignKey{
+				"Orders": {customerFK, referrerFK},
+			},
+			expectedReferencedBy: map[string][]foreignKey{
+				"Customers": {customerFK, referrerFK},
+			},
+		},
+		{
+			name: "IgnoreField",
+			opt:  Option{IgnoreFields: []string{"ReferrerId"}},
+			expectedForeignKeys: map[string][]foreignKey{
+				"Orders": {
+					func() foreignKey { fk := customerFK; fk.FuncName, fk.RefFuncName = "Customer", "Orders"; return fk }(),
+					productFK,
+				},
+			},
+			expectedReferencedBy: map[string][]foreignKey{
+				"Customers": {
+					func() foreignKey { fk := customerFK; fk.FuncName, fk.RefFuncName = "Customer", "Orders"; return fk }(),
+				},
+				"Products": {productFK},
 			},
 		},
 	}
 
 	for _, tc := range table {
 		t.Run(tc.name, func(t *testing.T) {
-			l := setUpTypeLoader(t, tc.schema, tc.opt)
+			l := setUpTypeLoader(t, schema, tc.opt)
 
 			schema, err := l.LoadSchema()
 			if err != nil {
 				t.Fatalf("failed to load schema: %v", err)
 			}
 
-			compareSchemas(t, schema, tc.expectedSchema)
+			gotForeignKeys := make(map[string][]foreignKey)
+			gotReferencedBy := make(map[string][]foreignKey)
+			for _, typ := range schema.Types {
+				if fks := convert(typ.ForeignKeys); fks != nil {
+					gotForeignKeys[typ.TableName] = fks
+				}
+				if fks := convert(typ.ReferencedBy); fks != nil {
+					gotReferencedBy[typ.TableName] = fks
+				}
+			}
+
+			if diff := cmp.Diff(gotForeignKeys, tc.expectedForeignKeys); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+			if diff := cmp.Diff(gotReferencedBy, tc.expectedReferencedBy); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
 		})
 	}
 }
@@ -398,6 +1972,48 @@ func TestLoader_CustomTypes(t *testing.T) {
 			schema:      simpleSchema,
 			expectedErr: "unknown custom type column UnknownColumn in the table Simple",
 		},
+		{
+			name: "Custom type is undefined",
+			opt: Option{
+				Config: &config.Config{
+					Tables: []config.Table{
+						{
+							Name: "Simple",
+							Columns: []config.Column{
+								{
+									Name:       "Id",
+									CustomType: "uint46",
+								},
+							},
+						},
+					},
+				},
+				PackageDir: "testdata/models",
+			},
+			schema:      simpleSchema,
+			expectedErr: "custom type uint46 of the column Id in the table Simple: undefined: uint46",
+		},
+		{
+			name: "Custom type is not convertible",
+			opt: Option{
+				Config: &config.Config{
+					Tables: []config.Table{
+						{
+							Name: "Simple",
+							Columns: []config.Column{
+								{
+									Name:       "Value",
+									CustomType: "bool",
+								},
+							},
+						},
+					},
+				},
+				PackageDir: "testdata/models",
+			},
+			schema:      simpleSchema,
+			expectedErr: "custom type bool of the column Value in the table Simple: not convertible to string and doesn't implement spanner.Encoder and spanner.Decoder",
+		},
 		{
 			name: "Success",
 			opt: Option{
@@ -414,15 +2030,18 @@ func TestLoader_CustomTypes(t *testing.T) {
 						},
 					},
 				},
+				PackageDir: "testdata/models",
 			},
 			schema: simpleSchema,
 			expectedSchema: &models.Schema{
+				Dialect: models.DialectGoogleSQL,
 				Types: []*models.Type{
 					{
 						Name: "Simple",
 						PrimaryKeyFields: []*models.Field{
 							{ColumnName: "Id"},
 						},
+						PrimaryKeyDirections: []string{"ASC"},
 						Fields: []*models.Field{
 							{
 								Name:            "ID",
@@ -456,7 +2075,91 @@ func TestLoader_CustomTypes(t *testing.T) {
 								Fields: []*models.Field{
 									{ColumnName: "Value"},
 								},
-								IndexName: "SimpleIndex",
+								KeyDirections: []string{"ASC"},
+								IndexName:     "SimpleIndex",
+							},
+							{
+								Name:           "SimpleIndex2",
+								FuncName:       "SimpleBySimpleIndex2",
+								LegacyFuncName: "SimpleByIDValue",
+								Fields: []*models.Field{
+									{ColumnName: "Id"},
+									{ColumnName: "Value"},
+								},
+								KeyDirections: []string{"ASC", "ASC"},
+								IndexName:     "SimpleIndex2",
+								IsUnique:      true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Custom type qualified by the import path",
+			opt: Option{
+				Config: &config.Config{
+					Tables: []config.Table{
+						{
+							Name: "Simple",
+							Columns: []config.Column{
+								{
+									Name:       "Value",
+									CustomType: "go.mercari.io/yo/v2/loader/testdata/models.Value",
+								},
+							},
+						},
+					},
+				},
+			},
+			schema: simpleSchema,
+			expectedSchema: &models.Schema{
+				Dialect: models.DialectGoogleSQL,
+				Imports: []string{`"go.mercari.io/yo/v2/loader/testdata/models"`},
+				Types: []*models.Type{
+					{
+						Name: "Simple",
+						PrimaryKeyFields: []*models.Field{
+							{ColumnName: "Id"},
+						},
+						PrimaryKeyDirections: []string{"ASC"},
+						Fields: []*models.Field{
+							{
+								Name:            "ID",
+								Type:            "int64",
+								OriginalType:    "int64",
+								NullValue:       "0",
+								Len:             -1,
+								ColumnName:      "Id",
+								SpannerDataType: "INT64",
+								IsNotNull:       true,
+								IsPrimaryKey:    true,
+							},
+							{
+								Name:            "Value",
+								Type:            "models.Value",
+								OriginalType:    "string",
+								NullValue:       `""`,
+								Len:             32,
+								ColumnName:      "Value",
+								SpannerDataType: "STRING(32)",
+								IsNotNull:       true,
+								IsPrimaryKey:    false,
+
+								CustomTypeImportPath: "go.mercari.io/yo/v2/loader/testdata/models",
+							},
+						},
+						TableName: "Simple",
+						Indexes: []*models.Index{
+							{
+								Name:           "SimpleIndex",
+								FuncName:       "SimplesBySimpleIndex",
+								LegacyFuncName: "SimplesByValue",
+								Fields: []*models.Field{
+									{ColumnName: "Value"},
+								},
+								KeyDirections: []string{"ASC"},
+								IndexName:     "SimpleIndex",
 							},
 							{
 								Name:           "SimpleIndex2",
@@ -466,13 +2169,38 @@ func TestLoader_CustomTypes(t *testing.T) {
 									{ColumnName: "Id"},
 									{ColumnName: "Value"},
 								},
-								IndexName: "SimpleIndex2",
-								IsUnique:  true,
+								KeyDirections: []string{"ASC", "ASC"},
+								IndexName:     "SimpleIndex2",
+								IsUnique:      true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Custom types of the packages of the same name",
+			opt: Option{
+				Config: &config.Config{
+					Tables: []config.Table{
+						{
+							Name: "Simple",
+							Columns: []config.Column{
+								{
+									Name:       "Id",
+									CustomType: "github.com/acme/money.Amount",
+								},
+								{
+									Name:       "Value",
+									CustomType: "github.com/example/money/v2.Currency",
+								},
 							},
 						},
 					},
 				},
 			},
+			schema:      simpleSchema,
+			expectedErr: "custom type money.Currency of the column Value in the table Simple: packages github.com/acme/money and github.com/example/money/v2 have the same name money",
 		},
 	}
 
@@ -613,6 +2341,31 @@ func Test_setIndexesToTables(t *testing.T) {
 	}
 }
 
+// interleave links the child type with the parent type in the schema.
+func interleave(schema *models.Schema, parent, child, onDelete string) *models.Schema {
+	var p, c *models.Type
+	for _, t := range schema.Types {
+		switch t.TableName {
+		case parent:
+			p = t
+		case child:
+			c = t
+		}
+	}
+
+	c.Parent = p
+	c.OnDeleteAction = onDelete
+	p.Children = append(p.Children, c)
+
+	for _, ix := range c.Indexes {
+		if ix.ParentTableName == parent {
+			ix.Parent = p
+		}
+	}
+
+	return schema
+}
+
 func setUpTypeLoader(t *testing.T, schema string, opt Option) *TypeLoader {
 	t.Helper()
 
@@ -20,120 +20,1040 @@
 package loader
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cloudspannerecosystem/memefish"
 	"github.com/cloudspannerecosystem/memefish/ast"
 	"github.com/cloudspannerecosystem/memefish/token"
+
+	"go.mercari.io/yo/v2/models"
 )
 
-func extractName(path *ast.Path) (string, error) {
-	if len(path.Idents) != 1 {
-		return "", fmt.Errorf("path isn't simple ident: %v", path.SQL())
+// extractName extracts a name from path. A name in a named schema is
+// qualified by the schema such as sales.Orders.
+func extractName(path *ast.Path) (string, error) {
+	switch len(path.Idents) {
+	case 1:
+		return path.Idents[0].Name, nil
+	case 2:
+		return qualifyName(path.Idents[0].Name, path.Idents[1].Name), nil
+	}
+	return "", newDDLError(path, "path isn't simple ident: %v", path.SQL())
+}
+
+// ddlError is an error caused by a node of a DDL statement.
+type ddlError struct {
+	node ast.Node
+	msg  string
+}
+
+func newDDLError(node ast.Node, format string, args ...interface{}) error {
+	return &ddlError{
+		node: node,
+		msg:  fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *ddlError) Error() string {
+	return e.msg
+}
+
+// positionError prefixes err with the position in the file where err is
+// caused. The position of the statement is used if err doesn't have a node.
+func positionError(file *token.File, stmt ast.Node, err error) error {
+	node := stmt
+	var de *ddlError
+	if errors.As(err, &de) {
+		node = de.node
+	}
+
+	return fmt.Errorf("%s: %w", file.Position(node.Pos(), node.End()), err)
+}
+
+// ddlComments finds comments of DDL statements and column definitions in a
+// file. memefish attaches comments to tokens but doesn't keep them in AST.
+type ddlComments struct {
+	buffer   string
+	comments []token.TokenComment // ordered by the position
+}
+
+func newDDLComments(file *token.File) (*ddlComments, error) {
+	lex := &memefish.Lexer{File: file}
+	c := &ddlComments{buffer: file.Buffer}
+	for {
+		if err := lex.NextToken(); err != nil {
+			return nil, err
+		}
+		c.comments = append(c.comments, lex.Token.Comments...)
+		if lex.Token.Kind == token.TokenEOF {
+			break
+		}
+	}
+	return c, nil
+}
+
+// leading returns the comment on the lines right above pos. A comment
+// separated by a blank line or following other tokens on its line is not
+// included.
+func (c *ddlComments) leading(pos token.Pos) string {
+	i := sort.Search(len(c.comments), func(i int) bool {
+		return c.comments[i].Pos >= pos
+	})
+
+	var lines []string
+	end := pos
+	for i--; i >= 0; i-- {
+		cm := c.comments[i]
+		between := c.buffer[int(cm.Pos)+len(strings.TrimRight(cm.Raw, "\r\n")) : end]
+		if strings.TrimSpace(between) != "" || strings.Count(between, "\n") > 1 {
+			break
+		}
+		lineStart := strings.LastIndex(c.buffer[:cm.Pos], "\n") + 1
+		if strings.TrimSpace(c.buffer[lineStart:cm.Pos]) != "" {
+			break
+		}
+		lines = append(commentLines(cm.Raw), lines...)
+		end = cm.Pos
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// trailing returns the comment following end on the same line such as a
+// comment after the comma of a column definition.
+func (c *ddlComments) trailing(end token.Pos) string {
+	i := sort.Search(len(c.comments), func(i int) bool {
+		return c.comments[i].Pos >= end
+	})
+	if i == len(c.comments) {
+		return ""
+	}
+
+	cm := c.comments[i]
+	if strings.Trim(c.buffer[end:cm.Pos], " \t,") != "" {
+		return ""
+	}
+	return strings.Join(commentLines(cm.Raw), "\n")
+}
+
+// commentLines strips the comment markers from a comment and splits it
+// into lines.
+func commentLines(raw string) []string {
+	block := strings.HasPrefix(raw, "/*")
+	switch {
+	case strings.HasPrefix(raw, "--"), strings.HasPrefix(raw, "//"):
+		raw = raw[2:]
+	case strings.HasPrefix(raw, "#"):
+		raw = raw[1:]
+	case block:
+		raw = strings.TrimSuffix(raw[2:], "*/")
+	}
+
+	var lines []string
+	for _, l := range strings.Split(raw, "\n") {
+		l = strings.TrimSpace(l)
+		if block {
+			// strip the leading asterisk of a line in a block comment
+			l = strings.TrimSpace(strings.TrimPrefix(l, "*"))
+		}
+		lines = append(lines, l)
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// columnComment returns the comments above and after a column definition.
+func (c *ddlComments) columnComment(col *ast.ColumnDef) string {
+	leading, trailing := c.leading(col.Pos()), c.trailing(col.End())
+	if leading != "" && trailing != "" {
+		return leading + "\n" + trailing
+	}
+	return leading + trailing
+}
+
+// NewSchemaParserSource creates a SchemaSource from DDL files. The statements
+// of the files are applied in the given order as one stream.
+func NewSchemaParserSource(fpaths ...string) (SchemaSource, error) {
+	s := &schemaParserSource{
+		tables:    make(map[string]table),
+		views:     make(map[string]view),
+		schemas:   make(map[string]struct{}),
+		sequences: make(map[string]*ast.CreateSequence),
+		graphs:    make(map[string]*ast.CreatePropertyGraph),
+		comments:  make(map[ast.Node]string),
+	}
+	for _, fpath := range fpaths {
+		b, err := os.ReadFile(fpath)
+		if err != nil {
+			return nil, err
+		}
+
+		ddls, err := memefish.ParseDDLs(fpath, string(b))
+		if err != nil {
+			var mes memefish.MultiError
+			if errors.As(err, &mes) && len(mes) > 0 {
+				return nil, fmt.Errorf("%s: syntax error: %s", mes[0].Position, mes[0].Message)
+			}
+			var me *memefish.Error
+			if errors.As(err, &me) {
+				return nil, fmt.Errorf("%s: syntax error: %s", me.Position, me.Message)
+			}
+			return nil, err
+		}
+
+		file := &token.File{FilePath: fpath, Buffer: string(b)}
+		s.fileComments, err = newDDLComments(file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ddlstmt := range ddls {
+			if err := s.apply(ddlstmt); err != nil {
+				return nil, positionError(file, ddlstmt, err)
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// apply replays a DDL statement on top of the schema built so far.
+func (s *schemaParserSource) apply(ddlstmt ast.DDL) error {
+	switch val := ddlstmt.(type) {
+	case *ast.CreateTable:
+		tableName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := s.tables[tableName]; ok {
+			if val.IfNotExists {
+				return nil
+			}
+			return newDDLError(val.Name, "table %s already exists", tableName)
+		}
+		if _, ok := s.views[tableName]; ok {
+			return newDDLError(val.Name, "view %s already exists", tableName)
+		}
+		if schema, _ := splitName(tableName); schema != "" {
+			if _, ok := s.schemas[schema]; !ok {
+				return newDDLError(val.Name, "unknown schema %s for the table %s", schema, tableName)
+			}
+		}
+		if val.Cluster != nil {
+			if _, err := extractName(val.Cluster.TableName); err != nil {
+				return err
+			}
+		}
+		if val.RowDeletionPolicy != nil {
+			if err := validateRowDeletionPolicy(val, tableName, val.RowDeletionPolicy.RowDeletionPolicy); err != nil {
+				return err
+			}
+		}
+
+		s.tables[tableName] = table{createTable: val}
+		s.comments[val] = s.fileComments.leading(val.Pos())
+		for _, col := range val.Columns {
+			s.comments[col] = s.fileComments.columnComment(col)
+		}
+	case *ast.CreateIndex:
+		tableName, err := extractName(val.TableName)
+		if err != nil {
+			return err
+		}
+		indexName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		v, ok := s.tables[tableName]
+		if !ok {
+			return newDDLError(val.TableName, "unknown index table %s for the index %s", tableName, indexName)
+		}
+
+		if s.indexExists(indexName) {
+			if val.IfNotExists {
+				return nil
+			}
+			return newDDLError(val.Name, "index %s already exists", indexName)
+		}
+
+		v.createIndexes = append(v.createIndexes, val)
+		s.tables[tableName] = v
+		s.comments[val] = s.fileComments.leading(val.Pos())
+	case *ast.CreateSearchIndex:
+		tableName, indexName := val.TableName.Name, val.Name.Name
+
+		v, ok := s.tables[tableName]
+		if !ok {
+			return newDDLError(val.TableName, "unknown index table %s for the search index %s", tableName, indexName)
+		}
+
+		if s.indexExists(indexName) {
+			return newDDLError(val.Name, "index %s already exists", indexName)
+		}
+
+		for _, c := range val.TokenListPart {
+			i, ok := findColumn(v.createTable, c.Name)
+			if !ok {
+				return newDDLError(c, "unknown column %s in the search index %s", c.Name, indexName)
+			}
+			if t, ok := v.createTable.Columns[i].Type.(*ast.ScalarSchemaType); !ok || t.Name != ast.TokenListTypeName {
+				return newDDLError(c, "column %s in the search index %s must be TOKENLIST", c.Name, indexName)
+			}
+		}
+
+		v.createSearchIndexes = append(v.createSearchIndexes, val)
+		s.tables[tableName] = v
+		s.comments[val] = s.fileComments.leading(val.Pos())
+	case *ast.CreateVectorIndex:
+		tableName, indexName := val.TableName.Name, val.Name.Name
+
+		v, ok := s.tables[tableName]
+		if !ok {
+			return newDDLError(val.TableName, "unknown index table %s for the vector index %s", tableName, indexName)
+		}
+
+		if _, _, ok := s.findVectorIndex(indexName); ok && val.IfNotExists {
+			return nil
+		}
+		if s.indexExists(indexName) {
+			return newDDLError(val.Name, "index %s already exists", indexName)
+		}
+
+		i, ok := findColumn(v.createTable, val.ColumnName.Name)
+		if !ok {
+			return newDDLError(val.ColumnName, "unknown column %s in the vector index %s", val.ColumnName.Name, indexName)
+		}
+		if _, l := columnType(v.createTable.Columns[i]); l == 0 {
+			return newDDLError(val.ColumnName, "column %s in the vector index %s must have vector_length", val.ColumnName.Name, indexName)
+		}
+
+		v.createVectorIndexes = append(v.createVectorIndexes, val)
+		s.tables[tableName] = v
+		s.comments[val] = s.fileComments.leading(val.Pos())
+	case *ast.DropVectorIndex:
+		tableName, i, ok := s.findVectorIndex(val.Name.Name)
+		if !ok {
+			if val.IfExists {
+				return nil
+			}
+			return newDDLError(val.Name, "unknown vector index %s", val.Name.Name)
+		}
+
+		v := s.tables[tableName]
+		v.createVectorIndexes = append(v.createVectorIndexes[:i:i], v.createVectorIndexes[i+1:]...)
+		s.tables[tableName] = v
+	case *ast.AlterSearchIndex:
+		return s.alterSearchIndex(val)
+	case *ast.DropSearchIndex:
+		tableName, i, ok := s.findSearchIndex(val.Name.Name)
+		if !ok {
+			if val.IfExists {
+				return nil
+			}
+			return newDDLError(val.Name, "unknown search index %s", val.Name.Name)
+		}
+
+		v := s.tables[tableName]
+		v.createSearchIndexes = append(v.createSearchIndexes[:i:i], v.createSearchIndexes[i+1:]...)
+		s.tables[tableName] = v
+	case *ast.CreateView:
+		viewName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := s.tables[viewName]; ok {
+			return newDDLError(val.Name, "table %s already exists", viewName)
+		}
+		if _, ok := s.views[viewName]; ok && !val.OrReplace {
+			return newDDLError(val.Name, "view %s already exists", viewName)
+		}
+		if schema, _ := splitName(viewName); schema != "" {
+			if _, ok := s.schemas[schema]; !ok {
+				return newDDLError(val.Name, "unknown schema %s for the view %s", schema, viewName)
+			}
+		}
+
+		columns, err := s.viewColumns(viewName, val.Query)
+		if err != nil {
+			return err
+		}
+
+		s.views[viewName] = view{createView: val, columns: columns}
+		s.comments[val] = s.fileComments.leading(val.Pos())
+	case *ast.DropView:
+		viewName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := s.views[viewName]; !ok {
+			return newDDLError(val.Name, "unknown view %s", viewName)
+		}
+
+		delete(s.views, viewName)
+	case *ast.CreateSequence:
+		sequenceName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := s.sequences[sequenceName]; ok {
+			if val.IfNotExists {
+				return nil
+			}
+			return newDDLError(val.Name, "sequence %s already exists", sequenceName)
+		}
+		if schema, _ := splitName(sequenceName); schema != "" {
+			if _, ok := s.schemas[schema]; !ok {
+				return newDDLError(val.Name, "unknown schema %s for the sequence %s", schema, sequenceName)
+			}
+		}
+
+		s.sequences[sequenceName] = val
+	case *ast.AlterSequence:
+		sequenceName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		seq, ok := s.sequences[sequenceName]
+		if !ok {
+			return newDDLError(val.Name, "unknown sequence %s", sequenceName)
+		}
+
+		seq.Options = mergeOptions(seq.Options, val.Options)
+	case *ast.DropSequence:
+		sequenceName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := s.sequences[sequenceName]; !ok {
+			if val.IfExists {
+				return nil
+			}
+			return newDDLError(val.Name, "unknown sequence %s", sequenceName)
+		}
+
+		delete(s.sequences, sequenceName)
+	case *ast.CreatePropertyGraph:
+		graphName := val.Name.Name
+
+		if _, ok := s.graphs[graphName]; ok && !val.OrReplace {
+			if val.IfNotExists {
+				return nil
+			}
+			return newDDLError(val.Name, "property graph %s already exists", graphName)
+		}
+
+		if _, err := s.propertyGraph(val); err != nil {
+			return err
+		}
+
+		s.graphs[graphName] = val
+		s.comments[val] = s.fileComments.leading(val.Pos())
+	case *ast.DropPropertyGraph:
+		if _, ok := s.graphs[val.Name.Name]; !ok {
+			if val.IfExists {
+				return nil
+			}
+			return newDDLError(val.Name, "unknown property graph %s", val.Name.Name)
+		}
+
+		delete(s.graphs, val.Name.Name)
+	case *ast.CreateSchema:
+		if _, ok := s.schemas[val.Name.Name]; ok {
+			return newDDLError(val.Name, "schema %s already exists", val.Name.Name)
+		}
+		s.schemas[val.Name.Name] = struct{}{}
+	case *ast.DropSchema:
+		if _, ok := s.schemas[val.Name.Name]; !ok {
+			return newDDLError(val.Name, "unknown schema %s", val.Name.Name)
+		}
+		delete(s.schemas, val.Name.Name)
+	case *ast.AlterTable:
+		return s.alterTable(val)
+	case *ast.DropTable:
+		tableName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := s.tables[tableName]; !ok {
+			if val.IfExists {
+				return nil
+			}
+			return newDDLError(val.Name, "unknown table %s", tableName)
+		}
+
+		delete(s.tables, tableName)
+	case *ast.AlterIndex:
+		return s.alterIndex(val)
+	case *ast.DropIndex:
+		indexName, err := extractName(val.Name)
+		if err != nil {
+			return err
+		}
+
+		tableName, i, ok := s.findIndex(indexName)
+		if !ok {
+			if val.IfExists {
+				return nil
+			}
+			return newDDLError(val.Name, "unknown index %s", indexName)
+		}
+
+		v := s.tables[tableName]
+		v.createIndexes = append(v.createIndexes[:i:i], v.createIndexes[i+1:]...)
+		s.tables[tableName] = v
+	}
+
+	return nil
+}
+
+func (s *schemaParserSource) alterTable(at *ast.AlterTable) error {
+	tableName, err := extractName(at.Name)
+	if err != nil {
+		return err
+	}
+
+	v, ok := s.tables[tableName]
+	if !ok {
+		if isAlterTableAddFK(at) {
+			return nil
+		}
+		return newDDLError(at.Name, "unknown table %s", tableName)
+	}
+	ct := v.createTable
+
+	switch alt := at.TableAlteration.(type) {
+	case *ast.AddColumn:
+		if _, ok := findColumn(ct, alt.Column.Name.Name); ok {
+			if alt.IfNotExists {
+				return nil
+			}
+			return newDDLError(alt.Column.Name, "column %s already exists in the table %s", alt.Column.Name.Name, tableName)
+		}
+		ct.Columns = append(ct.Columns, alt.Column)
+		s.comments[alt.Column] = s.fileComments.columnComment(alt.Column)
+	case *ast.DropColumn:
+		i, ok := findColumn(ct, alt.Name.Name)
+		if !ok {
+			return newDDLError(alt.Name, "unknown column %s in the table %s", alt.Name.Name, tableName)
+		}
+		if ref := s.columnReference(tableName, alt.Name.Name); ref != "" {
+			return newDDLError(alt.Name, "column %s in the table %s is referenced by %s", alt.Name.Name, tableName, ref)
+		}
+		ct.Columns = append(ct.Columns[:i:i], ct.Columns[i+1:]...)
+	case *ast.AlterColumn:
+		i, ok := findColumn(ct, alt.Name.Name)
+		if !ok {
+			return newDDLError(alt.Name, "unknown column %s in the table %s", alt.Name.Name, tableName)
+		}
+		alterColumn(ct.Columns[i], alt.Alteration)
+	case *ast.AddTableConstraint:
+		ct.TableConstraints = append(ct.TableConstraints, alt.TableConstraint)
+	case *ast.DropConstraint:
+		for i, tc := range ct.TableConstraints {
+			if tc.Name != nil && tc.Name.Name == alt.Name.Name {
+				ct.TableConstraints = append(ct.TableConstraints[:i:i], ct.TableConstraints[i+1:]...)
+				break
+			}
+		}
+	case *ast.AddRowDeletionPolicy:
+		if err := validateRowDeletionPolicy(ct, tableName, alt.RowDeletionPolicy); err != nil {
+			return err
+		}
+		ct.RowDeletionPolicy = &ast.CreateRowDeletionPolicy{RowDeletionPolicy: alt.RowDeletionPolicy}
+	case *ast.ReplaceRowDeletionPolicy:
+		if err := validateRowDeletionPolicy(ct, tableName, alt.RowDeletionPolicy); err != nil {
+			return err
+		}
+		ct.RowDeletionPolicy = &ast.CreateRowDeletionPolicy{RowDeletionPolicy: alt.RowDeletionPolicy}
+	case *ast.DropRowDeletionPolicy:
+		ct.RowDeletionPolicy = nil
+	case *ast.SetOnDelete:
+		if ct.Cluster != nil {
+			ct.Cluster.OnDelete = alt.OnDelete
+		}
+	case *ast.AddSynonym, *ast.DropSynonym:
+		// synonyms do not affect generated code
+	default:
+		return newDDLError(at.TableAlteration, "unknown statement is specified: %s", at.SQL())
+	}
+
+	return nil
+}
+
+func isAlterTableAddFK(at *ast.AlterTable) bool {
+	ac, ok := at.TableAlteration.(*ast.AddTableConstraint)
+	if !ok {
+		return false
+	}
+	_, ok = ac.TableConstraint.Constraint.(*ast.ForeignKey)
+	return ok
+}
+
+func alterColumn(col *ast.ColumnDef, alteration ast.ColumnAlteration) {
+	switch alt := alteration.(type) {
+	case *ast.AlterColumnType:
+		col.Type = alt.Type
+		col.NotNull = alt.NotNull
+		if alt.DefaultExpr != nil {
+			col.DefaultSemantics = alt.DefaultExpr
+		}
+	case *ast.AlterColumnSetOptions:
+		col.Options = mergeOptions(col.Options, alt.Options)
+	case *ast.AlterColumnSetDefault:
+		col.DefaultSemantics = alt.DefaultExpr
+	case *ast.AlterColumnDropDefault:
+		if _, ok := col.DefaultSemantics.(*ast.ColumnDefaultExpr); ok {
+			col.DefaultSemantics = nil
+		}
+	}
+}
+
+// mergeOptions overrides records of base by records of opts. A record set to
+// NULL is removed.
+func mergeOptions(base, opts *ast.Options) *ast.Options {
+	var records []*ast.OptionsDef
+	if base != nil {
+		records = append(records, base.Records...)
+	}
+
+	for _, r := range opts.Records {
+		for i, br := range records {
+			if br.Name.Name == r.Name.Name {
+				records = append(records[:i:i], records[i+1:]...)
+				break
+			}
+		}
+		if _, ok := r.Value.(*ast.NullLiteral); ok {
+			continue
+		}
+		records = append(records, r)
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+	return &ast.Options{Records: records}
+}
+
+// defaultExpr returns the SQL of the default expression of a column.
+func defaultExpr(c *ast.ColumnDef) string {
+	expr, ok := c.DefaultSemantics.(*ast.ColumnDefaultExpr)
+	if !ok {
+		return ""
+	}
+	return expr.Expr.SQL()
+}
+
+// isGenerated reports whether a column is a generated column.
+func isGenerated(c *ast.ColumnDef) bool {
+	_, ok := c.DefaultSemantics.(*ast.GeneratedColumnExpr)
+	return ok
+}
+
+// generationExpr returns the SQL of the expression of a generated column.
+func generationExpr(c *ast.ColumnDef) string {
+	expr, ok := c.DefaultSemantics.(*ast.GeneratedColumnExpr)
+	if !ok {
+		return ""
+	}
+	return expr.Expr.SQL()
+}
+
+// boolOption reports whether the option name is set to true in opts.
+func boolOption(opts *ast.Options, name string) bool {
+	if opts == nil {
+		return false
+	}
+	for _, r := range opts.Records {
+		if r.Name.Name != name {
+			continue
+		}
+		if b, ok := r.Value.(*ast.BoolLiteral); ok {
+			return b.Value
+		}
+	}
+	return false
+}
+
+// stringOption returns the value of the string option.
+func stringOption(opts *ast.Options, name string) string {
+	if opts == nil {
+		return ""
+	}
+	for _, r := range opts.Records {
+		if r.Name.Name != name {
+			continue
+		}
+		if s, ok := r.Value.(*ast.StringLiteral); ok {
+			return s.Value
+		}
+	}
+	return ""
+}
+
+// intOption returns the value of the integer option.
+func intOption(opts *ast.Options, name string) int64 {
+	if opts == nil {
+		return 0
+	}
+	for _, r := range opts.Records {
+		if r.Name.Name != name {
+			continue
+		}
+		if i, ok := r.Value.(*ast.IntLiteral); ok {
+			v, _ := strconv.ParseInt(i.Value, 0, 64)
+			return v
+		}
+	}
+	return 0
+}
+
+// columnType returns the data type and the vector_length of a column. The
+// vector_length is given by the type such as ARRAY<FLOAT32>(vector_length=>128)
+// or by the option of the column.
+func columnType(c *ast.ColumnDef) (string, int64) {
+	a, ok := c.Type.(*ast.ArraySchemaType)
+	if !ok || len(a.NamedArgs) == 0 {
+		return c.Type.SQL(), intOption(c.Options, "vector_length")
+	}
+
+	var l int64
+	for _, arg := range a.NamedArgs {
+		if i, ok := arg.Value.(*ast.IntLiteral); ok && arg.Name.Name == "vector_length" {
+			l, _ = strconv.ParseInt(i.Value, 0, 64)
+		}
+	}
+
+	t := *a
+	t.NamedArgs = nil
+	return t.SQL(), l
+}
+
+func (s *schemaParserSource) alterIndex(ai *ast.AlterIndex) error {
+	indexName, err := extractName(ai.Name)
+	if err != nil {
+		return err
+	}
+
+	tableName, i, ok := s.findIndex(indexName)
+	if !ok {
+		return newDDLError(ai.Name, "unknown index %s", indexName)
+	}
+	ix := s.tables[tableName].createIndexes[i]
+
+	ix.Storing, err = alterStoring(ix.Storing, ai.IndexAlteration, indexName)
+	return err
+}
+
+func (s *schemaParserSource) alterSearchIndex(ai *ast.AlterSearchIndex) error {
+	indexName := ai.Name.Name
+	tableName, i, ok := s.findSearchIndex(indexName)
+	if !ok {
+		return newDDLError(ai.Name, "unknown search index %s", indexName)
+	}
+	ix := s.tables[tableName].createSearchIndexes[i]
+
+	var err error
+	ix.Storing, err = alterStoring(ix.Storing, ai.IndexAlteration, indexName)
+	return err
+}
+
+// alterStoring applies ADD STORED COLUMN or DROP STORED COLUMN to the storing
+// clause of an index and returns the altered clause.
+func alterStoring(storing *ast.Storing, alteration ast.IndexAlteration, indexName string) (*ast.Storing, error) {
+	switch alt := alteration.(type) {
+	case *ast.AddStoredColumn:
+		if storing == nil {
+			storing = &ast.Storing{}
+		}
+		storing.Columns = append(storing.Columns, alt.Name)
+	case *ast.DropStoredColumn:
+		if storing == nil {
+			return nil, newDDLError(alt.Name, "unknown storing column %s in the index %s", alt.Name.Name, indexName)
+		}
+		j := -1
+		for k, c := range storing.Columns {
+			if c.Name == alt.Name.Name {
+				j = k
+				break
+			}
+		}
+		if j == -1 {
+			return nil, newDDLError(alt.Name, "unknown storing column %s in the index %s", alt.Name.Name, indexName)
+		}
+		storing.Columns = append(storing.Columns[:j:j], storing.Columns[j+1:]...)
+		if len(storing.Columns) == 0 {
+			storing = nil
+		}
+	default:
+		return nil, newDDLError(alteration, "unknown statement is specified: %s", alteration.SQL())
+	}
+
+	return storing, nil
+}
+
+// findIndex returns the table name and the position of the index in the table.
+func (s *schemaParserSource) findIndex(name string) (string, int, bool) {
+	for tableName, t := range s.tables {
+		for i, ix := range t.createIndexes {
+			ixName, err := extractName(ix.Name)
+			if err != nil {
+				continue
+			}
+			if ixName == name {
+				return tableName, i, true
+			}
+		}
 	}
-	return path.Idents[0].Name, nil
+
+	return "", 0, false
 }
 
-func NewSchemaParserSource(fpath string) (SchemaSource, error) {
-	b, err := os.ReadFile(fpath)
-	if err != nil {
-		return nil, err
+// findSearchIndex returns the table name and the position of the search index
+// in the table.
+func (s *schemaParserSource) findSearchIndex(name string) (string, int, bool) {
+	for tableName, t := range s.tables {
+		for i, ix := range t.createSearchIndexes {
+			if ix.Name.Name == name {
+				return tableName, i, true
+			}
+		}
 	}
 
-	tables := make(map[string]table)
-	stmts := strings.Split(string(b), ";")
-	for _, stmt := range stmts {
-		stmt := strings.TrimSpace(stmt)
-		if stmt == "" {
-			continue
+	return "", 0, false
+}
+
+// findVectorIndex returns the table name and the position of the vector index
+// in the table.
+func (s *schemaParserSource) findVectorIndex(name string) (string, int, bool) {
+	for tableName, t := range s.tables {
+		for i, ix := range t.createVectorIndexes {
+			if ix.Name.Name == name {
+				return tableName, i, true
+			}
 		}
+	}
 
-		ddlstmt, err := memefish.ParseDDL("", stmt)
-		if err != nil {
-			return nil, err
+	return "", 0, false
+}
+
+// indexExists reports whether an index, a search index or a vector index of
+// the name exists. They share the namespace.
+func (s *schemaParserSource) indexExists(name string) bool {
+	_, _, index := s.findIndex(name)
+	_, _, searchIndex := s.findSearchIndex(name)
+	_, _, vectorIndex := s.findVectorIndex(name)
+	return index || searchIndex || vectorIndex
+}
+
+// onDeleteAction returns the action in the same format as ON_DELETE_ACTION of
+// INFORMATION_SCHEMA.TABLES. NO ACTION is the default of interleaved tables.
+func onDeleteAction(action ast.OnDeleteAction) string {
+	if action == ast.OnDeleteCascade {
+		return "CASCADE"
+	}
+	return "NO ACTION"
+}
+
+func findColumn(ct *ast.CreateTable, name string) (int, bool) {
+	for i, c := range ct.Columns {
+		if c.Name.Name == name {
+			return i, true
 		}
+	}
 
-		switch val := ddlstmt.(type) {
-		case *ast.CreateTable:
-			tableName, err := extractName(val.Name)
-			if err != nil {
-				return nil, err
+	return 0, false
+}
+
+// columnReference returns what references the column of the table, i.e. the
+// primary key, an index, a foreign key or the row deletion policy. It returns
+// an empty string if the column is not referenced.
+func (s *schemaParserSource) columnReference(tableName, column string) string {
+	containsIdent := func(idents []*ast.Ident) bool {
+		for _, id := range idents {
+			if id.Name == column {
+				return true
 			}
+		}
+		return false
+	}
 
-			v := tables[tableName]
-			v.createTable = val
-			tables[tableName] = v
-		case *ast.CreateIndex:
-			tableName, err := extractName(val.TableName)
+	v := s.tables[tableName]
+	ct := v.createTable
+	for _, k := range ct.PrimaryKeys {
+		if k.Name.Name == column {
+			return "the primary key"
+		}
+	}
+	for _, ix := range v.createIndexes {
+		keys := make([]*ast.Ident, 0, len(ix.Keys))
+		for _, k := range ix.Keys {
+			keys = append(keys, k.Name)
+		}
+		if containsIdent(keys) || (ix.Storing != nil && containsIdent(ix.Storing.Columns)) {
+			ixName, err := extractName(ix.Name)
 			if err != nil {
-				return nil, err
+				continue
 			}
+			return "the index " + ixName
+		}
+	}
+	for _, ix := range v.createSearchIndexes {
+		if containsIdent(ix.TokenListPart) || containsIdent(ix.PartitionColumns) || (ix.Storing != nil && containsIdent(ix.Storing.Columns)) {
+			return "the search index " + ix.Name.Name
+		}
+	}
+	for _, ix := range v.createVectorIndexes {
+		if ix.ColumnName.Name == column || (ix.Storing != nil && containsIdent(ix.Storing.Columns)) {
+			return "the vector index " + ix.Name.Name
+		}
+	}
+	if rdp := ct.RowDeletionPolicy; rdp != nil && rdp.RowDeletionPolicy.ColumnName.Name == column {
+		return "the row deletion policy"
+	}
+
+	// foreign keys of the other tables may reference the column
+	names := make([]string, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-			v := tables[tableName]
-			v.createIndexes = append(v.createIndexes, val)
-			tables[tableName] = v
-		case *ast.AlterTable:
-			if isAlterTableAddFK(val) {
+	for _, name := range names {
+		for _, tc := range s.tables[name].createTable.TableConstraints {
+			fk, ok := tc.Constraint.(*ast.ForeignKey)
+			if !ok {
 				continue
 			}
-			return nil, fmt.Errorf("unknown statement is specified: %s", ddlstmt.SQL())
+			refTable, err := extractName(fk.ReferenceTable)
+			if err != nil {
+				continue
+			}
+			if (name == tableName && containsIdent(fk.Columns)) || (refTable == tableName && containsIdent(fk.ReferenceColumns)) {
+				if tc.Name != nil {
+					return "the foreign key " + tc.Name.Name
+				}
+				return "a foreign key of the table " + name
+			}
 		}
 	}
 
-	return &schemaParserSource{tables: tables}, nil
+	return ""
 }
 
-func isAlterTableAddFK(at *ast.AlterTable) bool {
-	ac, ok := at.TableAlteration.(*ast.AddTableConstraint)
+// validateRowDeletionPolicy validates that the column of the row deletion
+// policy is a TIMESTAMP column of the table.
+func validateRowDeletionPolicy(ct *ast.CreateTable, tableName string, policy *ast.RowDeletionPolicy) error {
+	i, ok := findColumn(ct, policy.ColumnName.Name)
 	if !ok {
-		return false
+		return newDDLError(policy.ColumnName, "unknown column %s in the row deletion policy of the table %s", policy.ColumnName.Name, tableName)
 	}
-	_, ok = ac.TableConstraint.Constraint.(*ast.ForeignKey)
-	return ok
+	if t, ok := ct.Columns[i].Type.(*ast.ScalarSchemaType); !ok || t.Name != ast.TimestampTypeName {
+		return newDDLError(policy.ColumnName, "column %s in the row deletion policy of the table %s must be TIMESTAMP", policy.ColumnName.Name, tableName)
+	}
+	if _, err := strconv.ParseInt(policy.NumDays.Value, 0, 64); err != nil {
+		return newDDLError(policy.NumDays, "invalid days %s in the row deletion policy of the table %s", policy.NumDays.Value, tableName)
+	}
+
+	return nil
 }
 
 type table struct {
-	createTable   *ast.CreateTable
-	createIndexes []*ast.CreateIndex
+	createTable         *ast.CreateTable
+	createIndexes       []*ast.CreateIndex
+	createSearchIndexes []*ast.CreateSearchIndex
+	createVectorIndexes []*ast.CreateVectorIndex
 }
 
 type schemaParserSource struct {
-	tables map[string]table
+	tables    map[string]table
+	views     map[string]view
+	schemas   map[string]struct{}
+	sequences map[string]*ast.CreateSequence
+	graphs    map[string]*ast.CreatePropertyGraph
+
+	comments     map[ast.Node]string // comments of tables, views, columns, indexes and graphs
+	fileComments *ddlComments        // comments of the file being applied
+}
+
+// Dialect returns the GoogleSQL dialect because only GoogleSQL DDL is supported.
+func (s *schemaParserSource) Dialect() models.Dialect {
+	return models.DialectGoogleSQL
 }
 
 func (s *schemaParserSource) TableList() ([]*SpannerTable, error) {
 	var tables []*SpannerTable
 	for _, t := range s.tables {
-		var parent string
+		var parent, onDelete string
 		if t.createTable.Cluster != nil {
 			var err error
 			parent, err = extractName(t.createTable.Cluster.TableName)
 			if err != nil {
 				return nil, err
 			}
+			onDelete = onDeleteAction(t.createTable.Cluster.OnDelete)
 		}
 		tableName, err := extractName(t.createTable.Name)
 		if err != nil {
 			return nil, err
 		}
 
+		var policyColumn string
+		var policyDays int64
+		if p := t.createTable.RowDeletionPolicy; p != nil {
+			policyColumn = p.RowDeletionPolicy.ColumnName.Name
+			// the days are validated when the policy is applied
+			policyDays, _ = strconv.ParseInt(p.RowDeletionPolicy.NumDays.Value, 0, 64)
+		}
+
 		tables = append(tables, &SpannerTable{
-			TableName:       tableName,
-			ParentTableName: parent,
+			TableName:               tableName,
+			ParentTableName:         parent,
+			OnDeleteAction:          onDelete,
+			RowDeletionPolicyColumn: policyColumn,
+			RowDeletionPolicyDays:   policyDays,
+			Comment:                 s.comments[t.createTable],
+		})
+	}
+
+	for viewName, v := range s.views {
+		tables = append(tables, &SpannerTable{
+			TableName: viewName,
+			IsView:    true,
+			Comment:   s.comments[v.createView],
 		})
 	}
 
 	sort.Slice(tables, func(i, j int) bool {
-		return tables[i].TableName < tables[j].TableName
+		return lessName(tables[i].TableName, tables[j].TableName)
 	})
 
 	return tables, nil
 }
 
 func (s *schemaParserSource) ColumnList(name string) ([]*SpannerColumn, error) {
+	if v, ok := s.views[name]; ok {
+		return v.columns, nil
+	}
+
 	var cols []*SpannerColumn
 	table := s.tables[name].createTable
 
@@ -144,14 +1064,21 @@ func (s *schemaParserSource) ColumnList(name string) ([]*SpannerColumn, error) {
 
 	for i, c := range table.Columns {
 		_, pk := check[c.Name.Name]
+		dataType, vectorLength := columnType(c)
 		cols = append(cols, &SpannerColumn{
 			FieldOrdinal: i + 1,
 			ColumnName:   c.Name.Name,
-			DataType:     c.Type.SQL(),
+			DataType:     dataType,
 			NotNull:      c.NotNull,
 			IsPrimaryKey: pk,
-			IsGenerated:  c.GeneratedExpr != nil,
+			IsGenerated:  isGenerated(c),
 			IsHidden:     c.Hidden != token.InvalidPos,
+
+			AllowCommitTimestamp: boolOption(c.Options, "allow_commit_timestamp"),
+			DefaultExpr:          defaultExpr(c),
+			GenerationExpr:       generationExpr(c),
+			VectorLength:         vectorLength,
+			Comment:              s.comments[c],
 		})
 	}
 
@@ -166,9 +1093,19 @@ func (s *schemaParserSource) IndexList(name string) ([]*SpannerIndex, error) {
 			return nil, err
 		}
 
+		var parent string
+		if index.InterleaveIn != nil {
+			// an index is interleaved in a table in the same schema
+			schema, _ := splitName(name)
+			parent = qualifyName(schema, index.InterleaveIn.TableName.Name)
+		}
+
 		indexes = append(indexes, &SpannerIndex{
-			IndexName: indexName,
-			IsUnique:  index.Unique,
+			IndexName:       indexName,
+			IsUnique:        index.Unique,
+			IsNullFiltered:  index.NullFiltered,
+			ParentTableName: parent,
+			Comment:         s.comments[index],
 		})
 	}
 
@@ -205,6 +1142,32 @@ func (s *schemaParserSource) IndexColumnList(table, index string) ([]*SpannerInd
 			cols = append(cols, &SpannerIndexColumn{
 				SeqNo:      i + 1,
 				ColumnName: c.Name.Name,
+				Desc:       c.Dir == ast.DirectionDesc,
+			})
+		}
+		return cols, nil
+	}
+
+	// the TOKENLIST columns of a search index are the keys
+	for _, ix := range s.tables[table].createSearchIndexes {
+		if ix.Name.Name != index {
+			continue
+		}
+
+		if ix.Storing != nil {
+			for _, storing := range ix.Storing.Columns {
+				cols = append(cols, &SpannerIndexColumn{
+					SeqNo:      0,
+					Storing:    true,
+					ColumnName: storing.Name,
+				})
+			}
+		}
+
+		for i, c := range ix.TokenListPart {
+			cols = append(cols, &SpannerIndexColumn{
+				SeqNo:      i + 1,
+				ColumnName: c.Name,
 			})
 		}
 		break
@@ -213,6 +1176,90 @@ func (s *schemaParserSource) IndexColumnList(table, index string) ([]*SpannerInd
 	return cols, nil
 }
 
+func (s *schemaParserSource) VectorIndexList(name string) ([]*SpannerVectorIndex, error) {
+	var indexes []*SpannerVectorIndex
+	for _, index := range s.tables[name].createVectorIndexes {
+		indexes = append(indexes, &SpannerVectorIndex{
+			IndexName:    index.Name.Name,
+			ColumnName:   index.ColumnName.Name,
+			DistanceType: strings.ToUpper(stringOption(index.Options, "distance_type")),
+			Comment:      s.comments[index],
+		})
+	}
+
+	// same order as INFORMATION_SCHEMA
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].IndexName < indexes[j].IndexName
+	})
+
+	return indexes, nil
+}
+
+func (s *schemaParserSource) SearchIndexList(name string) ([]*SpannerSearchIndex, error) {
+	var indexes []*SpannerSearchIndex
+	for _, index := range s.tables[name].createSearchIndexes {
+		indexes = append(indexes, &SpannerSearchIndex{
+			IndexName: index.Name.Name,
+			Comment:   s.comments[index],
+		})
+	}
+
+	// same order as INFORMATION_SCHEMA
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].IndexName < indexes[j].IndexName
+	})
+
+	return indexes, nil
+}
+
+func (s *schemaParserSource) ForeignKeyList(table string) ([]*SpannerForeignKey, error) {
+	tbl, ok := s.tables[table]
+	if !ok {
+		return nil, nil
+	}
+
+	var fks []*SpannerForeignKey
+	for _, tc := range tbl.createTable.TableConstraints {
+		fk, ok := tc.Constraint.(*ast.ForeignKey)
+		if !ok {
+			continue
+		}
+
+		refTableName, err := extractName(fk.ReferenceTable)
+		if err != nil {
+			return nil, err
+		}
+
+		var constraintName string
+		if tc.Name != nil {
+			constraintName = tc.Name.Name
+		}
+
+		var cols, refCols []string
+		for _, c := range fk.Columns {
+			cols = append(cols, c.Name)
+		}
+		for _, c := range fk.ReferenceColumns {
+			refCols = append(refCols, c.Name)
+		}
+
+		fks = append(fks, &SpannerForeignKey{
+			ConstraintName:        constraintName,
+			ColumnNames:           cols,
+			ReferencedTableName:   refTableName,
+			ReferencedColumnNames: refCols,
+			OnDeleteAction:        onDeleteAction(fk.OnDelete),
+		})
+	}
+
+	// same order as INFORMATION_SCHEMA
+	sort.SliceStable(fks, func(i, j int) bool {
+		return fks[i].ConstraintName < fks[j].ConstraintName
+	})
+
+	return fks, nil
+}
+
 func (s *schemaParserSource) primaryKeyColumnList(table string) ([]*SpannerIndexColumn, error) {
 	tbl, ok := s.tables[table]
 	if !ok {
@@ -224,8 +1271,286 @@ func (s *schemaParserSource) primaryKeyColumnList(table string) ([]*SpannerIndex
 		cols = append(cols, &SpannerIndexColumn{
 			SeqNo:      i + 1,
 			ColumnName: key.Name.Name,
+			Desc:       key.Dir == ast.DirectionDesc,
 		})
 	}
 
 	return cols, nil
 }
+
+func (s *schemaParserSource) PropertyGraphList() ([]*SpannerPropertyGraph, error) {
+	var graphs []*SpannerPropertyGraph
+	for _, cg := range s.graphs {
+		g, err := s.propertyGraph(cg)
+		if err != nil {
+			return nil, err
+		}
+		graphs = append(graphs, g)
+	}
+
+	sort.Slice(graphs, func(i, j int) bool {
+		return lessName(graphs[i].GraphName, graphs[j].GraphName)
+	})
+
+	return graphs, nil
+}
+
+// propertyGraph resolves the elements of a property graph on the tables. The
+// key columns of an edge referencing a node default to the key of the node.
+func (s *schemaParserSource) propertyGraph(cg *ast.CreatePropertyGraph) (*SpannerPropertyGraph, error) {
+	graphName := cg.Name.Name
+	g := &SpannerPropertyGraph{
+		GraphName: graphName,
+		Comment:   s.comments[cg],
+	}
+
+	elements := make(map[string]*SpannerGraphElement)
+	add := func(e *ast.PropertyGraphElement, edge bool) (*SpannerGraphElement, error) {
+		elem, err := s.graphElement(graphName, e, edge)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := elements[elem.ElementName]; ok {
+			return nil, newDDLError(e, "element %s already exists in the property graph %s", elem.ElementName, graphName)
+		}
+		elements[elem.ElementName] = elem
+		return elem, nil
+	}
+
+	for _, e := range cg.Content.NodeTables.Tables.Elements {
+		elem, err := add(e, false)
+		if err != nil {
+			return nil, err
+		}
+		g.Nodes = append(g.Nodes, elem)
+	}
+
+	if cg.Content.EdgeTables == nil {
+		return g, nil
+	}
+
+	nodes := make(map[string]*SpannerGraphElement)
+	for _, n := range g.Nodes {
+		nodes[n.ElementName] = n
+	}
+
+	for _, e := range cg.Content.EdgeTables.Tables.Elements {
+		elem, err := add(e, true)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := e.Keys.(*ast.PropertyGraphEdgeElementKeys)
+		refs := []struct {
+			ref        *ast.Ident
+			refColumns *ast.PropertyGraphColumnNameList
+			keyColumns []string
+			columns    *[]string
+		}{
+			{keys.Source.ElementReference, keys.Source.ReferenceColumns, elem.SourceKeyColumns, &elem.SourceNodeColumns},
+			{keys.Destination.ElementReference, keys.Destination.ReferenceColumns, elem.DestinationKeyColumns, &elem.DestinationNodeColumns},
+		}
+		for _, r := range refs {
+			node, ok := nodes[r.ref.Name]
+			if !ok {
+				return nil, newDDLError(r.ref, "unknown node table %s referenced by the edge table %s in the property graph %s", r.ref.Name, elem.ElementName, graphName)
+			}
+
+			columns := node.KeyColumns
+			if r.refColumns != nil {
+				columns, err = s.graphColumns(graphName, node.TableName, r.refColumns)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if len(columns) != len(r.keyColumns) {
+				return nil, newDDLError(r.ref, "edge table %s references %d columns of the node table %s by %d columns", elem.ElementName, len(columns), node.ElementName, len(r.keyColumns))
+			}
+			*r.columns = columns
+		}
+		elem.SourceNode = keys.Source.ElementReference.Name
+		elem.DestinationNode = keys.Destination.ElementReference.Name
+
+		g.Edges = append(g.Edges, elem)
+	}
+
+	return g, nil
+}
+
+// graphElement resolves a node or edge table of a property graph. The label
+// of an element is the element name unless the labels are defined.
+func (s *schemaParserSource) graphElement(graphName string, e *ast.PropertyGraphElement, edge bool) (*SpannerGraphElement, error) {
+	tableName := e.Name.Name
+	t, ok := s.tables[tableName]
+	if !ok {
+		return nil, newDDLError(e.Name, "unknown table %s in the property graph %s", tableName, graphName)
+	}
+
+	elem := &SpannerGraphElement{
+		ElementName: tableName,
+		TableName:   tableName,
+	}
+	if e.Alias != nil {
+		elem.ElementName = e.Alias.Name
+	}
+
+	for _, key := range t.createTable.PrimaryKeys {
+		elem.KeyColumns = append(elem.KeyColumns, key.Name.Name)
+	}
+
+	var err error
+	switch keys := e.Keys.(type) {
+	case nil:
+		if edge {
+			return nil, newDDLError(e.Name, "edge table %s in the property graph %s must have source and destination keys", elem.ElementName, graphName)
+		}
+	case *ast.PropertyGraphNodeElementKey:
+		if edge {
+			return nil, newDDLError(keys, "edge table %s in the property graph %s must have source and destination keys", elem.ElementName, graphName)
+		}
+		elem.KeyColumns, err = s.graphColumns(graphName, tableName, keys.Key.Keys)
+		if err != nil {
+			return nil, err
+		}
+	case *ast.PropertyGraphEdgeElementKeys:
+		if !edge {
+			return nil, newDDLError(keys, "node table %s in the property graph %s must not have source and destination keys", elem.ElementName, graphName)
+		}
+		if keys.Element != nil {
+			elem.KeyColumns, err = s.graphColumns(graphName, tableName, keys.Element.Keys)
+			if err != nil {
+				return nil, err
+			}
+		}
+		elem.SourceKeyColumns, err = s.graphColumns(graphName, tableName, keys.Source.Keys)
+		if err != nil {
+			return nil, err
+		}
+		elem.DestinationKeyColumns, err = s.graphColumns(graphName, tableName, keys.Destination.Keys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// properties of all labels are exposed on the element
+	properties := make(map[string]bool)
+	addProperties := func(props ast.PropertyGraphElementProperties) error {
+		columns, err := s.graphProperties(graphName, tableName, props)
+		if err != nil {
+			return err
+		}
+		for _, c := range columns {
+			properties[c] = true
+		}
+		return nil
+	}
+
+	switch lp := e.Properties.(type) {
+	case nil:
+		elem.Labels = []string{elem.ElementName}
+		err = addProperties(nil)
+	case *ast.PropertyGraphSingleProperties:
+		elem.Labels = []string{elem.ElementName}
+		err = addProperties(lp.Properties)
+	case *ast.PropertyGraphLabelAndPropertiesList:
+		for _, l := range lp.LabelAndProperties {
+			switch label := l.Label.(type) {
+			case *ast.PropertyGraphElementLabelLabelName:
+				elem.Labels = append(elem.Labels, label.Name.Name)
+			case *ast.PropertyGraphElementLabelDefaultLabel:
+				elem.Labels = append(elem.Labels, elem.ElementName)
+			}
+			if err = addProperties(l.Properties); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range t.createTable.Columns {
+		if properties[c.Name.Name] {
+			elem.PropertyColumns = append(elem.PropertyColumns, c.Name.Name)
+		}
+	}
+
+	return elem, nil
+}
+
+// graphColumns returns the names of the columns of a table in a property
+// graph.
+func (s *schemaParserSource) graphColumns(graphName, tableName string, list *ast.PropertyGraphColumnNameList) ([]string, error) {
+	ct := s.tables[tableName].createTable
+
+	var columns []string
+	for _, c := range list.ColumnNameList {
+		if _, ok := findColumn(ct, c.Name); !ok {
+			return nil, newDDLError(c, "unknown column %s of the table %s in the property graph %s", c.Name, tableName, graphName)
+		}
+		columns = append(columns, c.Name)
+	}
+
+	return columns, nil
+}
+
+// graphProperties returns the columns exposed as the properties of the same
+// names. All columns are exposed if props is nil.
+func (s *schemaParserSource) graphProperties(graphName, tableName string, props ast.PropertyGraphElementProperties) ([]string, error) {
+	ct := s.tables[tableName].createTable
+
+	var columns []string
+	switch p := props.(type) {
+	case nil:
+		for _, c := range ct.Columns {
+			columns = append(columns, c.Name.Name)
+		}
+	case *ast.PropertyGraphPropertiesAre:
+		except := make(map[string]bool)
+		if p.ExceptColumns != nil {
+			names, err := s.graphColumns(graphName, tableName, p.ExceptColumns)
+			if err != nil {
+				return nil, err
+			}
+			for _, n := range names {
+				except[n] = true
+			}
+		}
+		for _, c := range ct.Columns {
+			if !except[c.Name.Name] {
+				columns = append(columns, c.Name.Name)
+			}
+		}
+	case *ast.PropertyGraphDerivedPropertyList:
+		for _, dp := range p.DerivedProperties {
+			ident, ok := dp.Expr.(*ast.Ident)
+			if !ok || (dp.Alias != nil && dp.Alias.Name != ident.Name) {
+				continue
+			}
+			if _, ok := findColumn(ct, ident.Name); ok {
+				columns = append(columns, ident.Name)
+			}
+		}
+	}
+
+	return columns, nil
+}
+
+func (s *schemaParserSource) SequenceList() ([]*SpannerSequence, error) {
+	var sequences []*SpannerSequence
+	for name, seq := range s.sequences {
+		sequences = append(sequences, &SpannerSequence{
+			SequenceName:     name,
+			SequenceKind:     stringOption(seq.Options, "sequence_kind"),
+			SkipRangeMin:     intOption(seq.Options, "skip_range_min"),
+			SkipRangeMax:     intOption(seq.Options, "skip_range_max"),
+			StartWithCounter: intOption(seq.Options, "start_with_counter"),
+		})
+	}
+
+	sort.Slice(sequences, func(i, j int) bool {
+		return lessName(sequences[i].SequenceName, sequences[j].SequenceName)
+	})
+
+	return sequences, nil
+}
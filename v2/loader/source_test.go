@@ -118,6 +118,7 @@ func TestSource(t *testing.T) {
 		expectedColumns      map[string][]*SpannerColumn
 		expectedIndex        map[string][]*SpannerIndex
 		expectedIndexColumns map[string][]*SpannerIndexColumn
+		expectedForeignKeys  map[string][]*SpannerForeignKey
 	}{
 		{
 			name:   "Simple",
@@ -280,6 +281,18 @@ func TestSource(t *testing.T) {
 				"ForeignItems": nil,
 			},
 			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+			expectedForeignKeys: map[string][]*SpannerForeignKey{
+				"Items": nil,
+				"ForeignItems": {
+					{
+						ConstraintName:        "FK_ItemID_ForeignItems",
+						ColumnNames:           []string{"ItemID"},
+						ReferencedTableName:   "Items",
+						ReferencedColumnNames: []string{"ID"},
+						OnDeleteAction:        "NO ACTION",
+					},
+				},
+			},
 		},
 		{
 			name:   "Interleave",
@@ -288,6 +301,7 @@ func TestSource(t *testing.T) {
 				{
 					TableName:       "Interleaved",
 					ParentTableName: "Parent",
+					OnDeleteAction:  "NO ACTION",
 				},
 				{
 					TableName: "Parent",
@@ -306,7 +320,7 @@ func TestSource(t *testing.T) {
 			expectedIndex: map[string][]*SpannerIndex{
 				"Parent": nil,
 				"Interleaved": {
-					{IndexName: "InterleavedKey"},
+					{IndexName: "InterleavedKey", ParentTableName: "Parent"},
 				},
 			},
 			expectedIndexColumns: map[string][]*SpannerIndexColumn{
@@ -412,8 +426,1171 @@ func TestSource(t *testing.T) {
 					if diff := cmp.Diff(tc.expectedIndexColumns, gotIndexColumns); diff != "" {
 						t.Errorf("(-got, +want)\n%s", diff)
 					}
+
+					if tc.expectedForeignKeys == nil {
+						return
+					}
+
+					gotForeignKeys := make(map[string][]*SpannerForeignKey)
+					for _, tbl := range tables {
+						fks, err := s.ForeignKeyList(tbl)
+						if err != nil {
+							t.Fatalf("ForeignKeyList failed: %v", err)
+						}
+						gotForeignKeys[tbl] = fks
+					}
+
+					if diff := cmp.Diff(tc.expectedForeignKeys, gotForeignKeys); diff != "" {
+						t.Errorf("(-got, +want)\n%s", diff)
+					}
 				})
 			}
 		})
 	}
 }
+
+func TestSchemaParserSource(t *testing.T) {
+	dir := t.TempDir()
+
+	table := []struct {
+		name                 string
+		schema               string
+		expectedTables       []*SpannerTable
+		expectedColumns      map[string][]*SpannerColumn
+		expectedIndex        map[string][]*SpannerIndex
+		expectedIndexColumns map[string][]*SpannerIndexColumn
+		expectedForeignKeys  map[string][]*SpannerForeignKey
+		expectedSequences    []*SpannerSequence
+		expectedSearchIndex  map[string][]*SpannerSearchIndex
+		expectedVectorIndex  map[string][]*SpannerVectorIndex
+		expectedGraphs       []*SpannerPropertyGraph
+		expectedErr          string
+	}{
+		{
+			name: "AlterTableColumns",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+  Value STRING(32) NOT NULL,
+  Obsolete BOOL,
+) PRIMARY KEY(Id);
+ALTER TABLE Simple ADD COLUMN Extra BYTES(MAX);
+ALTER TABLE Simple ADD COLUMN IF NOT EXISTS Extra BYTES(MAX);
+ALTER TABLE Simple DROP COLUMN Obsolete;
+ALTER TABLE Simple ALTER COLUMN Value STRING(MAX);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Simple"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Simple": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Value", DataType: "STRING(MAX)"},
+					{FieldOrdinal: 3, ColumnName: "Extra", DataType: "BYTES(MAX)"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Simple": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+		},
+		{
+			name: "DropTable",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE Obsolete (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE INDEX ObsoleteIndex ON Obsolete(Id);
+DROP INDEX ObsoleteIndex;
+DROP TABLE Obsolete;
+DROP TABLE IF EXISTS Obsolete;
+CREATE TABLE IF NOT EXISTS Simple (
+  Id STRING(MAX) NOT NULL,
+) PRIMARY KEY(Id);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Simple"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Simple": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Simple": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+		},
+		{
+			name: "AlterIndex",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+  Value STRING(32) NOT NULL,
+  X STRING(32) NOT NULL,
+  Y STRING(32) NOT NULL,
+) PRIMARY KEY(Id);
+CREATE INDEX SimpleIndex ON Simple(Value) STORING (X);
+CREATE INDEX IF NOT EXISTS SimpleIndex ON Simple(Id);
+ALTER INDEX SimpleIndex ADD STORED COLUMN Y;
+ALTER INDEX SimpleIndex DROP STORED COLUMN X;
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Simple"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Simple": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Value", DataType: "STRING(32)", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: "X", DataType: "STRING(32)", NotNull: true},
+					{FieldOrdinal: 4, ColumnName: "Y", DataType: "STRING(32)", NotNull: true},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Simple": {
+					{IndexName: "SimpleIndex"},
+				},
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{
+				"Simple/SimpleIndex": {
+					{SeqNo: 0, ColumnName: "Y", Storing: true},
+					{SeqNo: 1, ColumnName: "Value"},
+				},
+			},
+		},
+		{
+			name: "IndexAttributes",
+			schema: `
+CREATE TABLE Parent (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id DESC);
+CREATE TABLE Child (
+  Id INT64 NOT NULL,
+  ChildId INT64 NOT NULL,
+  Value STRING(32),
+) PRIMARY KEY(Id DESC, ChildId),
+INTERLEAVE IN PARENT Parent;
+CREATE NULL_FILTERED INDEX ChildByValue ON Child(Id, Value DESC), INTERLEAVE IN Parent;
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Child", ParentTableName: "Parent", OnDeleteAction: "NO ACTION"},
+				{TableName: "Parent"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Parent": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+				},
+				"Child": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "ChildId", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 3, ColumnName: "Value", DataType: "STRING(32)"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Parent": nil,
+				"Child": {
+					{IndexName: "ChildByValue", IsNullFiltered: true, ParentTableName: "Parent"},
+				},
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{
+				"Child/ChildByValue": {
+					{SeqNo: 1, ColumnName: "Id"},
+					{SeqNo: 2, ColumnName: "Value", Desc: true},
+				},
+			},
+		},
+		{
+			name: "Comments",
+			schema: `
+-- file header
+
+-- Singers are artists.
+# They sing songs.
+CREATE TABLE Singers ( -- not a column comment
+  SingerId INT64 NOT NULL, -- the ID
+  -- the name
+  /* of the singer */
+  Name STRING(MAX),
+
+  Bio STRING(MAX),
+) PRIMARY KEY(SingerId);
+ALTER TABLE Singers ADD COLUMN Debut DATE; -- not an added column comment
+/*
+ * singers by name
+ */
+CREATE INDEX SingersByName ON Singers(Name);
+ALTER TABLE Singers ADD COLUMN Genre STRING(MAX);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Singers", Comment: "Singers are artists.\nThey sing songs."},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Singers": {
+					{FieldOrdinal: 1, ColumnName: "SingerId", DataType: "INT64", NotNull: true, IsPrimaryKey: true, Comment: "the ID"},
+					{FieldOrdinal: 2, ColumnName: "Name", DataType: "STRING(MAX)", Comment: "the name\nof the singer"},
+					{FieldOrdinal: 3, ColumnName: "Bio", DataType: "STRING(MAX)"},
+					{FieldOrdinal: 4, ColumnName: "Debut", DataType: "DATE"},
+					{FieldOrdinal: 5, ColumnName: "Genre", DataType: "STRING(MAX)"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Singers": {
+					{IndexName: "SingersByName", Comment: "singers by name"},
+				},
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{
+				"Singers/SingersByName": {
+					{SeqNo: 1, ColumnName: "Name"},
+				},
+			},
+		},
+		{
+			name: "RowDeletionPolicy",
+			schema: `
+CREATE TABLE Sessions (
+  Id INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP,
+) PRIMARY KEY(Id),
+ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+CREATE TABLE Events (
+  Id INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(Id),
+ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 1 DAY));
+CREATE TABLE Logs (
+  Id INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(Id);
+ALTER TABLE Sessions REPLACE ROW DELETION POLICY (OLDER_THAN(UpdatedAt, INTERVAL 7 DAY));
+ALTER TABLE Events DROP ROW DELETION POLICY;
+ALTER TABLE Logs ADD ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 0x10 DAY));
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Events"},
+				{TableName: "Logs", RowDeletionPolicyColumn: "CreatedAt", RowDeletionPolicyDays: 16},
+				{TableName: "Sessions", RowDeletionPolicyColumn: "UpdatedAt", RowDeletionPolicyDays: 7},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Events": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "CreatedAt", DataType: "TIMESTAMP", NotNull: true},
+				},
+				"Logs": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "CreatedAt", DataType: "TIMESTAMP", NotNull: true},
+				},
+				"Sessions": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "CreatedAt", DataType: "TIMESTAMP", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: "UpdatedAt", DataType: "TIMESTAMP"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Events":   nil,
+				"Logs":     nil,
+				"Sessions": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+		},
+		{
+			name: "RowDeletionPolicyUnknownColumn",
+			schema: `
+CREATE TABLE Sessions (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id),
+ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+`,
+			expectedErr: "5:33: unknown column CreatedAt in the row deletion policy of the table Sessions",
+		},
+		{
+			name: "RowDeletionPolicyNotTimestamp",
+			schema: `
+CREATE TABLE Sessions (
+  Id INT64 NOT NULL,
+  CreatedAt DATE NOT NULL,
+) PRIMARY KEY(Id);
+ALTER TABLE Sessions ADD ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+`,
+			expectedErr: "6:58: column CreatedAt in the row deletion policy of the table Sessions must be TIMESTAMP",
+		},
+		{
+			name: "Sequences",
+			schema: `
+CREATE SEQUENCE OrderSequence OPTIONS (sequence_kind = "bit_reversed_positive", skip_range_min = 1, skip_range_max = 1000);
+CREATE SEQUENCE IF NOT EXISTS OrderSequence OPTIONS (sequence_kind = "bit_reversed_positive");
+CREATE SEQUENCE TempSequence OPTIONS (sequence_kind = "bit_reversed_positive");
+ALTER SEQUENCE OrderSequence SET OPTIONS (skip_range_min = NULL, skip_range_max = NULL, start_with_counter = 100);
+DROP SEQUENCE TempSequence;
+CREATE TABLE Orders (
+  Id INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE OrderSequence)),
+) PRIMARY KEY(Id);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Orders"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Orders": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true, DefaultExpr: "GET_NEXT_SEQUENCE_VALUE(SEQUENCE OrderSequence)"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Orders": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+			expectedSequences: []*SpannerSequence{
+				{SequenceName: "OrderSequence", SequenceKind: "bit_reversed_positive", StartWithCounter: 100},
+			},
+		},
+		{
+			name: "DuplicateSequence",
+			schema: `
+CREATE SEQUENCE OrderSequence OPTIONS (sequence_kind = "bit_reversed_positive");
+CREATE SEQUENCE OrderSequence OPTIONS (sequence_kind = "bit_reversed_positive");
+`,
+			expectedErr: "3:17: sequence OrderSequence already exists",
+		},
+		{
+			name: "AlterUnknownSequence",
+			schema: `
+ALTER SEQUENCE OrderSequence SET OPTIONS (start_with_counter = 100);
+`,
+			expectedErr: "2:16: unknown sequence OrderSequence",
+		},
+		{
+			name: "SemicolonInStatements",
+			schema: `
+-- comment with a semicolon; CREATE TABLE Ignored
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+  Value STRING(MAX) NOT NULL DEFAULT ("a;b"),
+  Concat STRING(MAX) AS (CONCAT(Value, ";")) STORED, /* ; */
+) PRIMARY KEY(Id);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Simple", Comment: "comment with a semicolon; CREATE TABLE Ignored"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Simple": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Value", DataType: "STRING(MAX)", NotNull: true, DefaultExpr: `"a;b"`},
+					{FieldOrdinal: 3, ColumnName: "Concat", DataType: "STRING(MAX)", IsGenerated: true, GenerationExpr: `CONCAT(Value, ";")`, Comment: ";"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Simple": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+		},
+		{
+			name: "SyntaxError",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE Broken (
+  Id INT64 NOT NULL
+  Value STRING(MAX),
+) PRIMARY KEY(Id);
+`,
+			expectedErr: "7:3: syntax error: expected token: ), but: <ident>",
+		},
+		{
+			name: "ForeignKeys",
+			schema: `
+CREATE TABLE Customers (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE Orders (
+  Id INT64 NOT NULL,
+  CustomerId INT64 NOT NULL,
+  ReferrerId INT64,
+  FOREIGN KEY (CustomerId) REFERENCES Customers (Id) ON DELETE CASCADE,
+) PRIMARY KEY(Id);
+ALTER TABLE Orders ADD CONSTRAINT FK_Referrer FOREIGN KEY (ReferrerId) REFERENCES Customers (Id);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Customers"},
+				{TableName: "Orders"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Customers": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+				},
+				"Orders": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "CustomerId", DataType: "INT64", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: "ReferrerId", DataType: "INT64"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Customers": nil,
+				"Orders":    nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+			expectedForeignKeys: map[string][]*SpannerForeignKey{
+				"Customers": nil,
+				"Orders": {
+					{
+						ColumnNames:           []string{"CustomerId"},
+						ReferencedTableName:   "Customers",
+						ReferencedColumnNames: []string{"Id"},
+						OnDeleteAction:        "CASCADE",
+					},
+					{
+						ConstraintName:        "FK_Referrer",
+						ColumnNames:           []string{"ReferrerId"},
+						ReferencedTableName:   "Customers",
+						ReferencedColumnNames: []string{"Id"},
+						OnDeleteAction:        "NO ACTION",
+					},
+				},
+			},
+		},
+		{
+			name: "CommitTimestamp",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+  UpdatedAt TIMESTAMP,
+  DeletedAt TIMESTAMP OPTIONS (allow_commit_timestamp = false),
+) PRIMARY KEY(Id);
+ALTER TABLE Simple ALTER COLUMN UpdatedAt SET OPTIONS (allow_commit_timestamp = true);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Simple"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Simple": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "CreatedAt", DataType: "TIMESTAMP", NotNull: true, AllowCommitTimestamp: true},
+					{FieldOrdinal: 3, ColumnName: "UpdatedAt", DataType: "TIMESTAMP", AllowCommitTimestamp: true},
+					{FieldOrdinal: 4, ColumnName: "DeletedAt", DataType: "TIMESTAMP"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Simple": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+		},
+		{
+			name: "DefaultValues",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+  Name STRING(32) NOT NULL DEFAULT ("unknown"),
+  Count INT64 NOT NULL DEFAULT (1),
+  CreatedAt TIMESTAMP,
+  UpdatedAt TIMESTAMP DEFAULT (CURRENT_TIMESTAMP()),
+) PRIMARY KEY(Id);
+ALTER TABLE Simple ALTER COLUMN CreatedAt SET DEFAULT (CURRENT_TIMESTAMP());
+ALTER TABLE Simple ALTER COLUMN UpdatedAt DROP DEFAULT;
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Simple"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Simple": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Name", DataType: "STRING(32)", NotNull: true, DefaultExpr: `"unknown"`},
+					{FieldOrdinal: 3, ColumnName: "Count", DataType: "INT64", NotNull: true, DefaultExpr: "1"},
+					{FieldOrdinal: 4, ColumnName: "CreatedAt", DataType: "TIMESTAMP", DefaultExpr: "CURRENT_TIMESTAMP()"},
+					{FieldOrdinal: 5, ColumnName: "UpdatedAt", DataType: "TIMESTAMP"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Simple": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+		},
+		{
+			name: "Views",
+			schema: `
+CREATE TABLE Singers (
+  SingerId INT64 NOT NULL,
+  Name STRING(MAX) NOT NULL,
+  Secret STRING(MAX) HIDDEN,
+) PRIMARY KEY(SingerId);
+CREATE TABLE Albums (
+  SingerId INT64 NOT NULL,
+  AlbumId INT64 NOT NULL,
+  Title STRING(32),
+) PRIMARY KEY(SingerId, AlbumId);
+CREATE VIEW AllSingers SQL SECURITY INVOKER AS SELECT * FROM Singers;
+CREATE VIEW SingerAlbums SQL SECURITY INVOKER AS
+SELECT s.SingerId, s.Name AS SingerName, Title, CAST(a.AlbumId AS STRING) AS AlbumKey
+FROM Singers AS s JOIN Albums AS a ON s.SingerId = a.SingerId;
+CREATE VIEW AlbumCounts SQL SECURITY INVOKER AS
+SELECT SingerId, COUNT(*) AS Count FROM SingerAlbums GROUP BY SingerId;
+CREATE VIEW Dropped SQL SECURITY INVOKER AS SELECT SingerId FROM Singers;
+CREATE OR REPLACE VIEW AllSingers SQL SECURITY INVOKER AS SELECT SingerId FROM Singers;
+DROP VIEW Dropped;
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "AlbumCounts", IsView: true},
+				{TableName: "Albums"},
+				{TableName: "AllSingers", IsView: true},
+				{TableName: "SingerAlbums", IsView: true},
+				{TableName: "Singers"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"AlbumCounts": {
+					{FieldOrdinal: 1, ColumnName: "SingerId", DataType: "INT64"},
+					{FieldOrdinal: 2, ColumnName: "Count", DataType: "INT64"},
+				},
+				"Albums": {
+					{FieldOrdinal: 1, ColumnName: "SingerId", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "AlbumId", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 3, ColumnName: "Title", DataType: "STRING(32)"},
+				},
+				"AllSingers": {
+					{FieldOrdinal: 1, ColumnName: "SingerId", DataType: "INT64"},
+				},
+				"SingerAlbums": {
+					{FieldOrdinal: 1, ColumnName: "SingerId", DataType: "INT64"},
+					{FieldOrdinal: 2, ColumnName: "SingerName", DataType: "STRING(MAX)"},
+					{FieldOrdinal: 3, ColumnName: "Title", DataType: "STRING(32)"},
+					{FieldOrdinal: 4, ColumnName: "AlbumKey", DataType: "STRING"},
+				},
+				"Singers": {
+					{FieldOrdinal: 1, ColumnName: "SingerId", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Name", DataType: "STRING(MAX)", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: "Secret", DataType: "STRING(MAX)", IsHidden: true},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"AlbumCounts":  nil,
+				"Albums":       nil,
+				"AllSingers":   nil,
+				"SingerAlbums": nil,
+				"Singers":      nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+		},
+		{
+			name: "ViewUnknownType",
+			schema: `
+CREATE TABLE Singers (
+  SingerId INT64 NOT NULL,
+) PRIMARY KEY(SingerId);
+CREATE VIEW Computed SQL SECURITY INVOKER AS SELECT SingerId + 1 AS Next FROM Singers;
+`,
+			expectedErr: "5:53: cannot infer the type of SingerId + 1 in the view Computed, use CAST",
+		},
+		{
+			name: "NamedSchemas",
+			schema: `
+CREATE SCHEMA sales;
+CREATE TABLE Customers (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE sales.Orders (
+  Id INT64 NOT NULL,
+  CustomerId INT64 NOT NULL,
+  CONSTRAINT FK_Customer FOREIGN KEY (CustomerId) REFERENCES Customers (Id),
+) PRIMARY KEY(Id);
+CREATE TABLE sales.OrderItems (
+  Id INT64 NOT NULL,
+  ItemId INT64 NOT NULL,
+) PRIMARY KEY(Id, ItemId),
+  INTERLEAVE IN PARENT sales.Orders ON DELETE CASCADE;
+CREATE INDEX sales.OrdersByCustomerId ON sales.Orders(CustomerId);
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Customers"},
+				{TableName: "sales.OrderItems", ParentTableName: "sales.Orders", OnDeleteAction: "CASCADE"},
+				{TableName: "sales.Orders"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Customers": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+				},
+				"sales.OrderItems": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "ItemId", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+				},
+				"sales.Orders": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "CustomerId", DataType: "INT64", NotNull: true},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Customers":        nil,
+				"sales.OrderItems": nil,
+				"sales.Orders": {
+					{IndexName: "sales.OrdersByCustomerId"},
+				},
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{
+				"sales.Orders/sales.OrdersByCustomerId": {
+					{SeqNo: 1, ColumnName: "CustomerId"},
+				},
+			},
+			expectedForeignKeys: map[string][]*SpannerForeignKey{
+				"Customers":        nil,
+				"sales.OrderItems": nil,
+				"sales.Orders": {
+					{
+						ConstraintName:        "FK_Customer",
+						ColumnNames:           []string{"CustomerId"},
+						ReferencedTableName:   "Customers",
+						ReferencedColumnNames: []string{"Id"},
+						OnDeleteAction:        "NO ACTION",
+					},
+				},
+			},
+		},
+		{
+			name: "UnknownSchema",
+			schema: `
+CREATE TABLE sales.Orders (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+`,
+			expectedErr: "2:14: unknown schema sales for the table sales.Orders",
+		},
+		{
+			name: "DuplicateTable",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+`,
+			expectedErr: "5:14: table Simple already exists",
+		},
+		{
+			name: "DropUnknownTable",
+			schema: `
+DROP TABLE Simple;
+`,
+			expectedErr: "2:12: unknown table Simple",
+		},
+		{
+			name: "DropUnknownColumn",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+ALTER TABLE Simple DROP COLUMN Value;
+`,
+			expectedErr: "5:32: unknown column Value in the table Simple",
+		},
+		{
+			name: "DropPrimaryKeyColumn",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+ALTER TABLE Simple DROP COLUMN Id;
+`,
+			expectedErr: "5:32: column Id in the table Simple is referenced by the primary key",
+		},
+		{
+			name: "DropIndexedColumn",
+			schema: `
+CREATE TABLE Simple (
+  Id INT64 NOT NULL,
+  Value STRING(MAX),
+) PRIMARY KEY(Id);
+CREATE INDEX SimpleByValue ON Simple(Value);
+ALTER TABLE Simple DROP COLUMN Value;
+`,
+			expectedErr: "7:32: column Value in the table Simple is referenced by the index SimpleByValue",
+		},
+		{
+			name: "DropReferencedColumn",
+			schema: `
+CREATE TABLE Customers (
+  Id INT64 NOT NULL,
+  Code STRING(MAX) NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE Orders (
+  Id INT64 NOT NULL,
+  CustomerCode STRING(MAX) NOT NULL,
+  CONSTRAINT FK_OrdersCustomers FOREIGN KEY (CustomerCode) REFERENCES Customers (Code),
+) PRIMARY KEY(Id);
+ALTER TABLE Customers DROP COLUMN Code;
+`,
+			expectedErr: "11:35: column Code in the table Customers is referenced by the foreign key FK_OrdersCustomers",
+		},
+		{
+			name: "SearchIndex",
+			schema: `
+CREATE TABLE Articles (
+  Id INT64 NOT NULL,
+  Title STRING(MAX) NOT NULL,
+  Score FLOAT64,
+  Title_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Title)) HIDDEN,
+) PRIMARY KEY(Id);
+CREATE INDEX ArticlesByTitle ON Articles(Title);
+-- searches titles
+CREATE SEARCH INDEX ArticlesIndex ON Articles(Title_Tokens) STORING (Score);
+CREATE SEARCH INDEX ObsoleteIndex ON Articles(Title_Tokens);
+ALTER SEARCH INDEX ArticlesIndex ADD STORED COLUMN Title;
+ALTER SEARCH INDEX ArticlesIndex DROP STORED COLUMN Score;
+DROP SEARCH INDEX ObsoleteIndex;
+DROP SEARCH INDEX IF EXISTS ObsoleteIndex;
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Articles"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Articles": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Title", DataType: "STRING(MAX)", NotNull: true},
+					{FieldOrdinal: 3, ColumnName: "Score", DataType: "FLOAT64"},
+					{FieldOrdinal: 4, ColumnName: "Title_Tokens", DataType: "TOKENLIST", IsGenerated: true, IsHidden: true, GenerationExpr: "TOKENIZE_FULLTEXT(Title)"},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Articles": {
+					{IndexName: "ArticlesByTitle"},
+				},
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{
+				"Articles/ArticlesByTitle": {
+					{SeqNo: 1, ColumnName: "Title"},
+				},
+				"Articles/ArticlesIndex": {
+					{SeqNo: 0, ColumnName: "Title", Storing: true},
+					{SeqNo: 1, ColumnName: "Title_Tokens"},
+				},
+			},
+			expectedSearchIndex: map[string][]*SpannerSearchIndex{
+				"Articles": {
+					{IndexName: "ArticlesIndex", Comment: "searches titles"},
+				},
+			},
+		},
+		{
+			name: "SearchIndexNotTokenList",
+			schema: `
+CREATE TABLE Articles (
+  Id INT64 NOT NULL,
+  Title STRING(MAX) NOT NULL,
+) PRIMARY KEY(Id);
+CREATE SEARCH INDEX ArticlesIndex ON Articles(Title);
+`,
+			expectedErr: "6:47: column Title in the search index ArticlesIndex must be TOKENLIST",
+		},
+		{
+			name: "DuplicateSearchIndex",
+			schema: `
+CREATE TABLE Articles (
+  Id INT64 NOT NULL,
+  Title_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Title)) HIDDEN,
+) PRIMARY KEY(Id);
+CREATE INDEX ArticlesIndex ON Articles(Id);
+CREATE SEARCH INDEX ArticlesIndex ON Articles(Title_Tokens);
+`,
+			expectedErr: "7:21: index ArticlesIndex already exists",
+		},
+		{
+			name: "VectorIndex",
+			schema: `
+CREATE TABLE Documents (
+  Id INT64 NOT NULL,
+  Embedding ARRAY<FLOAT32> OPTIONS (vector_length = 128),
+) PRIMARY KEY(Id);
+-- searches documents
+CREATE VECTOR INDEX DocumentsByEmbedding ON Documents(Embedding) WHERE Embedding IS NOT NULL OPTIONS (distance_type = 'COSINE');
+CREATE VECTOR INDEX IF NOT EXISTS DocumentsByEmbedding ON Documents(Embedding) OPTIONS (distance_type = 'EUCLIDEAN');
+CREATE VECTOR INDEX ObsoleteIndex ON Documents(Embedding) OPTIONS (distance_type = 'DOT_PRODUCT');
+DROP VECTOR INDEX ObsoleteIndex;
+DROP VECTOR INDEX IF EXISTS ObsoleteIndex;
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Documents"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Documents": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Embedding", DataType: "ARRAY<FLOAT32>", VectorLength: 128},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Documents": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+			expectedVectorIndex: map[string][]*SpannerVectorIndex{
+				"Documents": {
+					{IndexName: "DocumentsByEmbedding", ColumnName: "Embedding", DistanceType: "COSINE", Comment: "searches documents"},
+				},
+			},
+		},
+		{
+			name: "VectorIndexWithVectorLengthType",
+			schema: `
+CREATE TABLE Documents (
+  Id INT64 NOT NULL,
+  Embedding ARRAY<FLOAT32>(vector_length=>3),
+) PRIMARY KEY(Id);
+CREATE VECTOR INDEX DocumentsByEmbedding ON Documents(Embedding) OPTIONS (distance_type = 'COSINE');
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Documents"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Documents": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Embedding", DataType: "ARRAY<FLOAT32>", VectorLength: 3},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Documents": nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+			expectedVectorIndex: map[string][]*SpannerVectorIndex{
+				"Documents": {
+					{IndexName: "DocumentsByEmbedding", ColumnName: "Embedding", DistanceType: "COSINE"},
+				},
+			},
+		},
+		{
+			name: "VectorIndexWithoutVectorLength",
+			schema: `
+CREATE TABLE Documents (
+  Id INT64 NOT NULL,
+  Embedding ARRAY<FLOAT32>,
+) PRIMARY KEY(Id);
+CREATE VECTOR INDEX DocumentsByEmbedding ON Documents(Embedding) OPTIONS (distance_type = 'COSINE');
+`,
+			expectedErr: "6:55: column Embedding in the vector index DocumentsByEmbedding must have vector_length",
+		},
+		{
+			name: "PropertyGraph",
+			schema: `
+CREATE TABLE Person (
+  Id INT64 NOT NULL,
+  Name STRING(MAX),
+) PRIMARY KEY(Id);
+CREATE TABLE Account (
+  Id INT64 NOT NULL,
+  NickName STRING(MAX),
+  Secret STRING(MAX),
+) PRIMARY KEY(Id);
+CREATE TABLE PersonOwnAccount (
+  Id INT64 NOT NULL,
+  AccountId INT64 NOT NULL,
+) PRIMARY KEY(Id, AccountId);
+CREATE TABLE AccountTransferAccount (
+  Id INT64 NOT NULL,
+  ToId INT64 NOT NULL,
+  Amount FLOAT64,
+) PRIMARY KEY(Id, ToId);
+CREATE PROPERTY GRAPH Unused NODE TABLES (Person);
+DROP PROPERTY GRAPH Unused;
+-- graph of the financial transactions
+CREATE PROPERTY GRAPH FinGraph
+  NODE TABLES (
+    Person KEY (Id) LABEL Person PROPERTIES (Id, Name AS FullName) LABEL Entity PROPERTIES (Id),
+    Account AS Acct PROPERTIES ARE ALL COLUMNS EXCEPT (Secret)
+  )
+  EDGE TABLES (
+    PersonOwnAccount
+      SOURCE KEY (Id) REFERENCES Person
+      DESTINATION KEY (AccountId) REFERENCES Acct (Id)
+      LABEL Owns,
+    AccountTransferAccount
+      SOURCE KEY (Id) REFERENCES Acct
+      DESTINATION KEY (ToId) REFERENCES Acct
+      DEFAULT LABEL NO PROPERTIES
+  );
+`,
+			expectedTables: []*SpannerTable{
+				{TableName: "Account"},
+				{TableName: "AccountTransferAccount"},
+				{TableName: "Person"},
+				{TableName: "PersonOwnAccount"},
+			},
+			expectedColumns: map[string][]*SpannerColumn{
+				"Account": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "NickName", DataType: "STRING(MAX)"},
+					{FieldOrdinal: 3, ColumnName: "Secret", DataType: "STRING(MAX)"},
+				},
+				"AccountTransferAccount": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "ToId", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 3, ColumnName: "Amount", DataType: "FLOAT64"},
+				},
+				"Person": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "Name", DataType: "STRING(MAX)"},
+				},
+				"PersonOwnAccount": {
+					{FieldOrdinal: 1, ColumnName: "Id", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+					{FieldOrdinal: 2, ColumnName: "AccountId", DataType: "INT64", NotNull: true, IsPrimaryKey: true},
+				},
+			},
+			expectedIndex: map[string][]*SpannerIndex{
+				"Account":                nil,
+				"AccountTransferAccount": nil,
+				"Person":                 nil,
+				"PersonOwnAccount":       nil,
+			},
+			expectedIndexColumns: map[string][]*SpannerIndexColumn{},
+			expectedGraphs: []*SpannerPropertyGraph{
+				{
+					GraphName: "FinGraph",
+					Nodes: []*SpannerGraphElement{
+						{ElementName: "Person", TableName: "Person", Labels: []string{"Person", "Entity"}, KeyColumns: []string{"Id"}, PropertyColumns: []string{"Id"}},
+						{ElementName: "Acct", TableName: "Account", Labels: []string{"Acct"}, KeyColumns: []string{"Id"}, PropertyColumns: []string{"Id", "NickName"}},
+					},
+					Edges: []*SpannerGraphElement{
+						{
+							ElementName: "PersonOwnAccount", TableName: "PersonOwnAccount", Labels: []string{"Owns"},
+							KeyColumns: []string{"Id", "AccountId"}, PropertyColumns: []string{"Id", "AccountId"},
+							SourceNode: "Person", SourceKeyColumns: []string{"Id"}, SourceNodeColumns: []string{"Id"},
+							DestinationNode: "Acct", DestinationKeyColumns: []string{"AccountId"}, DestinationNodeColumns: []string{"Id"},
+						},
+						{
+							ElementName: "AccountTransferAccount", TableName: "AccountTransferAccount", Labels: []string{"AccountTransferAccount"},
+							KeyColumns: []string{"Id", "ToId"},
+							SourceNode: "Acct", SourceKeyColumns: []string{"Id"}, SourceNodeColumns: []string{"Id"},
+							DestinationNode: "Acct", DestinationKeyColumns: []string{"ToId"}, DestinationNodeColumns: []string{"Id"},
+						},
+					},
+					Comment: "graph of the financial transactions",
+				},
+			},
+		},
+		{
+			name: "PropertyGraphEdgeWithoutKeys",
+			schema: `
+CREATE TABLE Person (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE PersonKnowsPerson (
+  Id INT64 NOT NULL,
+  OtherId INT64 NOT NULL,
+) PRIMARY KEY(Id, OtherId);
+CREATE PROPERTY GRAPH SocialGraph NODE TABLES (Person) EDGE TABLES (PersonKnowsPerson);
+`,
+			expectedErr: "9:69: edge table PersonKnowsPerson in the property graph SocialGraph must have source and destination keys",
+		},
+		{
+			name: "PropertyGraphUnknownNodeTable",
+			schema: `
+CREATE TABLE Person (
+  Id INT64 NOT NULL,
+) PRIMARY KEY(Id);
+CREATE TABLE PersonKnowsPerson (
+  Id INT64 NOT NULL,
+  OtherId INT64 NOT NULL,
+) PRIMARY KEY(Id, OtherId);
+CREATE PROPERTY GRAPH SocialGraph
+  NODE TABLES (Person)
+  EDGE TABLES (PersonKnowsPerson SOURCE KEY (Id) REFERENCES Person DESTINATION KEY (OtherId) REFERENCES People);
+`,
+			expectedErr: "11:105: unknown node table People referenced by the edge table PersonKnowsPerson in the property graph SocialGraph",
+		},
+		{
+			name: "UnknownSearchIndex",
+			schema: `
+DROP SEARCH INDEX ArticlesIndex;
+`,
+			expectedErr: "2:19: unknown search index ArticlesIndex",
+		},
+		{
+			name: "UnknownIndexTable",
+			schema: `
+CREATE INDEX SimpleIndex ON Simple(Value);
+`,
+			expectedErr: "2:29: unknown index table Simple for the index SimpleIndex",
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := os.CreateTemp(dir, "")
+			if err != nil {
+				t.Fatalf("failed to create temp file: %v", err)
+			}
+			_, _ = f.Write([]byte(tc.schema))
+			_ = f.Close()
+
+			s, err := NewSchemaParserSource(f.Name())
+			if tc.expectedErr != "" {
+				if err == nil {
+					t.Fatal("expected to create schema parser source failure")
+				}
+				if expected := f.Name() + ":" + tc.expectedErr; err.Error() != expected {
+					t.Fatalf("unexpected error: expected: %s, actual: %s", expected, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to create schema parser source: %v", err)
+			}
+
+			tbls, err := s.TableList()
+			if err != nil {
+				t.Fatalf("TableList failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectedTables, tbls); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+
+			gotColumns := make(map[string][]*SpannerColumn)
+			gotIndex := make(map[string][]*SpannerIndex)
+			gotIndexColumns := make(map[string][]*SpannerIndexColumn)
+			gotSearchIndex := make(map[string][]*SpannerSearchIndex)
+			gotVectorIndex := make(map[string][]*SpannerVectorIndex)
+			for _, tbl := range tbls {
+				columns, err := s.ColumnList(tbl.TableName)
+				if err != nil {
+					t.Fatalf("ColumnList failed: %v", err)
+				}
+				gotColumns[tbl.TableName] = columns
+
+				indexes, err := s.IndexList(tbl.TableName)
+				if err != nil {
+					t.Fatalf("IndexList failed: %v", err)
+				}
+				gotIndex[tbl.TableName] = indexes
+
+				for _, index := range indexes {
+					columns, err := s.IndexColumnList(tbl.TableName, index.IndexName)
+					if err != nil {
+						t.Fatalf("IndexColumnList failed: %v", err)
+					}
+					gotIndexColumns[fmt.Sprintf("%s/%s", tbl.TableName, index.IndexName)] = columns
+				}
+
+				searchIndexes, err := s.SearchIndexList(tbl.TableName)
+				if err != nil {
+					t.Fatalf("SearchIndexList failed: %v", err)
+				}
+				if searchIndexes != nil {
+					gotSearchIndex[tbl.TableName] = searchIndexes
+				}
+
+				for _, index := range searchIndexes {
+					columns, err := s.IndexColumnList(tbl.TableName, index.IndexName)
+					if err != nil {
+						t.Fatalf("IndexColumnList failed: %v", err)
+					}
+					gotIndexColumns[fmt.Sprintf("%s/%s", tbl.TableName, index.IndexName)] = columns
+				}
+
+				vectorIndexes, err := s.VectorIndexList(tbl.TableName)
+				if err != nil {
+					t.Fatalf("VectorIndexList failed: %v", err)
+				}
+				if vectorIndexes != nil {
+					gotVectorIndex[tbl.TableName] = vectorIndexes
+				}
+			}
+
+			if diff := cmp.Diff(tc.expectedColumns, gotColumns); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedIndex, gotIndex); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedIndexColumns, gotIndexColumns); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+			if tc.expectedSearchIndex != nil {
+				if diff := cmp.Diff(tc.expectedSearchIndex, gotSearchIndex); diff != "" {
+					t.Errorf("(-got, +want)\n%s", diff)
+				}
+			} else if len(gotSearchIndex) != 0 {
+				t.Errorf("unexpected search indexes: %v", gotSearchIndex)
+			}
+			if tc.expectedVectorIndex != nil {
+				if diff := cmp.Diff(tc.expectedVectorIndex, gotVectorIndex); diff != "" {
+					t.Errorf("(-got, +want)\n%s", diff)
+				}
+			} else if len(gotVectorIndex) != 0 {
+				t.Errorf("unexpected vector indexes: %v", gotVectorIndex)
+			}
+
+			if tc.expectedForeignKeys != nil {
+				gotForeignKeys := make(map[string][]*SpannerForeignKey)
+				for _, tbl := range tbls {
+					fks, err := s.ForeignKeyList(tbl.TableName)
+					if err != nil {
+						t.Fatalf("ForeignKeyList failed: %v", err)
+					}
+					gotForeignKeys[tbl.TableName] = fks
+				}
+
+				if diff := cmp.Diff(tc.expectedForeignKeys, gotForeignKeys); diff != "" {
+					t.Errorf("(-got, +want)\n%s", diff)
+				}
+			}
+
+			sequences, err := s.SequenceList()
+			if err != nil {
+				t.Fatalf("SequenceList failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectedSequences, sequences); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+
+			graphs, err := s.PropertyGraphList()
+			if err != nil {
+				t.Fatalf("PropertyGraphList failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectedGraphs, graphs); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRowDeletionPolicy(t *testing.T) {
+	table := []struct {
+		name           string
+		expr           string
+		expectedColumn string
+		expectedDays   int64
+		expectedErr    bool
+	}{
+		{
+			name:           "GoogleSQL",
+			expr:           "OLDER_THAN(CreatedAt, INTERVAL 30 DAY)",
+			expectedColumn: "CreatedAt",
+			expectedDays:   30,
+		},
+		{
+			name:           "PostgreSQL",
+			expr:           "INTERVAL '7 DAYS' ON created_at",
+			expectedColumn: "created_at",
+			expectedDays:   7,
+		},
+		{
+			name:        "Unsupported",
+			expr:        "OLDER_THAN(CreatedAt, INTERVAL 1 HOUR)",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			column, days, err := parseRowDeletionPolicy(tc.expr)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if column != tc.expectedColumn || days != tc.expectedDays {
+				t.Errorf("expected %v %v, but got %v %v", tc.expectedColumn, tc.expectedDays, column, days)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package models declares custom types for the tests of the loader.
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ID is a custom type of INT64 columns.
+type ID int64
+
+// Value is a custom type of STRING columns.
+type Value string
+
+// Values is a custom type of ARRAY<STRING> columns.
+type Values []Value
+
+// Timestamp is a custom type of TIMESTAMP columns.
+type Timestamp time.Time
+
+// Code is a custom type of STRING columns which encodes itself.
+type Code struct {
+	Prefix string
+	Number string
+}
+
+func (c Code) EncodeSpanner() (interface{}, error) {
+	return c.Prefix + "-" + c.Number, nil
+}
+
+func (c *Code) DecodeSpanner(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("not a string")
+	}
+	c.Prefix, c.Number, _ = strings.Cut(s, "-")
+	return nil
+}
+
+// Label is a custom type of STRING columns of views which only decodes itself.
+type Label struct {
+	Text string
+}
+
+func (l *Label) DecodeSpanner(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return errors.New("not a string")
+	}
+	l.Text = s
+	return nil
+}
+
+// DefaultLabel is not a type.
+var DefaultLabel = Label{}
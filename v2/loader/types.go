@@ -23,6 +23,13 @@ package loader
 type SpannerTable struct {
 	TableName       string // table_name
 	ParentTableName string
+	OnDeleteAction  string // on_delete_action. CASCADE or NO ACTION for an interleaved table
+	IsView          bool   // table_type is VIEW
+
+	RowDeletionPolicyColumn string // column of ROW DELETION POLICY. Empty if the table has no policy
+	RowDeletionPolicyDays   int64  // days of OLDER_THAN in ROW DELETION POLICY
+
+	Comment string // comment of CREATE TABLE or CREATE VIEW in DDL
 }
 
 // SpannerColumn represents column info.
@@ -34,13 +41,37 @@ type SpannerColumn struct {
 	IsPrimaryKey bool   // is_primary_key
 	IsGenerated  bool   // is_generated
 	IsHidden     bool   // is_hidden
+
+	AllowCommitTimestamp bool   // allow_commit_timestamp option
+	DefaultExpr          string // default expression, empty if not defined
+	GenerationExpr       string // expression of a generated column, empty if not generated
+	VectorLength         int64  // vector_length of an embedding column. 0 if not set
+	Comment              string // comment of the column definition in DDL
 }
 
 // SpannerIndex represents an index.
 type SpannerIndex struct {
+	IndexName       string // index name
+	IsUnique        bool   // the index is unique ro not
+	IsPrimary       bool   // the index is primary key or not
+	IsNullFiltered  bool   // the index is NULL_FILTERED or not
+	ParentTableName string // table which the index is interleaved in. Empty if not interleaved
+	Comment         string // comment of CREATE INDEX in DDL
+}
+
+// SpannerSearchIndex represents a search index. The TOKENLIST columns of the
+// index are listed as the keys of the index columns.
+type SpannerSearchIndex struct {
 	IndexName string // index name
-	IsUnique  bool   // the index is unique ro not
-	IsPrimary bool   // the index is primary key or not
+	Comment   string // comment of CREATE SEARCH INDEX in DDL
+}
+
+// SpannerVectorIndex represents a vector index.
+type SpannerVectorIndex struct {
+	IndexName    string // index name
+	ColumnName   string // embedding column of the index
+	DistanceType string // distance_type option such as COSINE
+	Comment      string // comment of CREATE VECTOR INDEX in DDL
 }
 
 // SpannerIndexColumn represents index column info.
@@ -48,4 +79,47 @@ type SpannerIndexColumn struct {
 	SeqNo      int    // seq_no. If is'a Storing Column, this value is 0.
 	ColumnName string // column_name
 	Storing    bool   // storing column or not
+	Desc       bool   // the key is sorted in descending order or not
+}
+
+// SpannerForeignKey represents a foreign key.
+type SpannerForeignKey struct {
+	ConstraintName        string   // constraint_name
+	ColumnNames           []string // column_name
+	ReferencedTableName   string   // table_name of the referenced table
+	ReferencedColumnNames []string // column_name of the referenced table
+	OnDeleteAction        string   // delete_rule. CASCADE or NO ACTION
+}
+
+// SpannerSequence represents a sequence.
+type SpannerSequence struct {
+	SequenceName     string // name
+	SequenceKind     string // sequence_kind option such as bit_reversed_positive
+	SkipRangeMin     int64  // skip_range_min option. 0 if not set
+	SkipRangeMax     int64  // skip_range_max option. 0 if not set
+	StartWithCounter int64  // start_with_counter option. 0 if not set
+}
+
+// SpannerPropertyGraph represents a property graph.
+type SpannerPropertyGraph struct {
+	GraphName string                 // property graph name
+	Nodes     []*SpannerGraphElement // node tables
+	Edges     []*SpannerGraphElement // edge tables
+	Comment   string                 // comment of CREATE PROPERTY GRAPH in DDL
+}
+
+// SpannerGraphElement represents a node or edge table of a property graph.
+type SpannerGraphElement struct {
+	ElementName     string   // alias of the table, or the table name
+	TableName       string   // input table of the element
+	Labels          []string // labels of the element
+	KeyColumns      []string // key of the element. The primary key if not defined
+	PropertyColumns []string // columns exposed as the properties of the same names
+
+	SourceNode             string   // element name of the source node of an edge
+	SourceKeyColumns       []string // columns of an edge referencing the source node
+	SourceNodeColumns      []string // columns of the source node referenced by SourceKeyColumns
+	DestinationNode        string   // element name of the destination node of an edge
+	DestinationKeyColumns  []string // columns of an edge referencing the destination node
+	DestinationNodeColumns []string // columns of the destination node referenced by DestinationKeyColumns
 }
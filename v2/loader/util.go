@@ -20,6 +20,7 @@
 package loader
 
 import (
+	"go/token"
 	"regexp"
 	"strconv"
 	"strings"
@@ -27,7 +28,11 @@ import (
 	"go.mercari.io/yo/v2/internal"
 )
 
-var lengthRegexp = regexp.MustCompile(`\(([0-9]+|MAX)\)$`)
+var (
+	lengthRegexp       = regexp.MustCompile(`\(([0-9]+|MAX)\)$`)
+	pgLengthRegexp     = regexp.MustCompile(`\(([0-9]+)\)$`)
+	vectorLengthRegexp = regexp.MustCompile(`\(vector_length=>([0-9]+)\)$`)
+)
 
 // SpanParseType parse a Spanner type into a Go type based on the column
 // definition.
@@ -87,7 +92,15 @@ func parseSpannerType(dt string, nullable bool) (int, string, string) {
 			typ = "spanner.NullFloat64"
 		}
 
-	case "BYTES":
+	case "FLOAT32":
+		nilVal = "0.0"
+		typ = "float32"
+		if nullable {
+			nilVal = "spanner.NullFloat32{}"
+			typ = "spanner.NullFloat32"
+		}
+
+	case "BYTES", "TOKENLIST":
 		typ = "[]byte"
 
 	case "TIMESTAMP":
@@ -114,6 +127,22 @@ func parseSpannerType(dt string, nullable bool) (int, string, string) {
 			typ = "spanner.NullNumeric"
 		}
 
+	case "UUID":
+		nilVal = "uuid.UUID{}"
+		typ = "uuid.UUID"
+		if nullable {
+			nilVal = "spanner.NullUUID{}"
+			typ = "spanner.NullUUID"
+		}
+
+	case "INTERVAL":
+		nilVal = "spanner.Interval{}"
+		typ = "spanner.Interval"
+		if nullable {
+			nilVal = "spanner.NullInterval{}"
+			typ = "spanner.NullInterval"
+		}
+
 	case "JSON":
 		nilVal = `spanner.NullJSON{Valid: true}`
 		typ = "spanner.NullJSON"
@@ -138,3 +167,209 @@ func parseSpannerType(dt string, nullable bool) (int, string, string) {
 
 	return length, nilVal, typ
 }
+
+// Modes of arrayElements in the config.
+const (
+	arrayElementsNullable = "nullable"
+	arrayElementsPointer  = "pointer"
+)
+
+// Modes of nullableFields in the config.
+const (
+	nullableFieldsNullable = "nullable"
+	nullableFieldsPointer  = "pointer"
+)
+
+// pointerElementTypes are the Go types whose pointers are array elements of
+// the pointer mode. The other types represent NULL by themselves.
+var pointerElementTypes = map[string]bool{
+	"bool":             true,
+	"string":           true,
+	"int64":            true,
+	"float32":          true,
+	"float64":          true,
+	"time.Time":        true,
+	"civil.Date":       true,
+	"big.Rat":          true,
+	"uuid.UUID":        true,
+	"spanner.Interval": true,
+}
+
+// pointerTypes are the Go types whose pointers are nullable columns of the
+// pointer mode of nullableFields. The other types represent NULL by
+// themselves, and spanner.Interval cannot be read into a pointer.
+var pointerTypes = map[string]bool{
+	"bool":       true,
+	"string":     true,
+	"int64":      true,
+	"float32":    true,
+	"float64":    true,
+	"time.Time":  true,
+	"civil.Date": true,
+	"big.Rat":    true,
+	"uuid.UUID":  true,
+}
+
+// arrayElementDataType returns the data type of the elements of an array
+// data type such as ARRAY<STRING(MAX)> or character varying[].
+func arrayElementDataType(dt string) (string, bool) {
+	if strings.HasPrefix(dt, "ARRAY<") {
+		return strings.TrimSuffix(strings.TrimPrefix(dt, "ARRAY<"), ">"), true
+	}
+	if strings.HasSuffix(dt, "[]") {
+		return strings.TrimSuffix(dt, "[]"), true
+	}
+	return "", false
+}
+
+// splitVectorLength splits vector_length from a data type such as
+// ARRAY<FLOAT32>(vector_length=>128). The length is 0 if dt has no
+// vector_length.
+func splitVectorLength(dt string) (string, int64) {
+	m := vectorLengthRegexp.FindStringSubmatchIndex(dt)
+	if m == nil {
+		return dt, 0
+	}
+
+	l, err := strconv.ParseInt(dt[m[2]:m[3]], 10, 64)
+	if err != nil {
+		panic("could not convert vector length")
+	}
+	return dt[:m[0]], l
+}
+
+// isTokenList reports whether dt is a TOKENLIST data type. TOKENLIST columns
+// cannot be read or written, so they are handled like hidden columns.
+func isTokenList(dt string) bool {
+	return dt == "TOKENLIST" || dt == "spanner.tokenlist"
+}
+
+// parseNullableArrayType parses an array type whose elements may be NULL
+// into a Go type by the mode of arrayElements.
+func parseNullableArrayType(parseType func(string, bool) (int, string, string), eleDataType string, mode string, nullable bool) (string, string) {
+	var eleTyp string
+	if mode == arrayElementsPointer {
+		_, _, eleTyp = parseType(eleDataType, false)
+		if pointerElementTypes[eleTyp] {
+			eleTyp = "*" + eleTyp
+		}
+	} else {
+		_, _, eleTyp = parseType(eleDataType, true)
+	}
+
+	typ := "[]" + eleTyp
+	if !nullable {
+		return typ + "{}", typ
+	}
+	return "nil", typ
+}
+
+// parsePointerType parses a nullable data type into a pointer of the Go type
+// of the non-null data type. ok is false if the type cannot be a pointer.
+func parsePointerType(parseType func(string, bool) (int, string, string), dataType string) (nilVal string, typ string, ok bool) {
+	_, _, typ = parseType(dataType, false)
+	if !pointerTypes[typ] {
+		return "", "", false
+	}
+	return "nil", "*" + typ, true
+}
+
+// isGoName reports whether name is an exported Go identifier, which can be
+// a Go name in the config.
+func isGoName(name string) bool {
+	return token.IsIdentifier(name) && token.IsExported(name)
+}
+
+// qualifyName qualifies name by the named schema. name is returned as is for
+// the default schema.
+func qualifyName(schema, name string) string {
+	if schema == "" {
+		return name
+	}
+	return schema + "." + name
+}
+
+// splitName splits a qualified name into the named schema and the name in the
+// schema. The schema is empty for the default schema.
+func splitName(name string) (string, string) {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// lessName compares qualified names by the schema first and the name second.
+// Names in the default schema come first.
+func lessName(a, b string) bool {
+	as, an := splitName(a)
+	bs, bn := splitName(b)
+	if as != bs {
+		return as < bs
+	}
+	return an < bn
+}
+
+// postgreSQLTypes maps PostgreSQL types of Spanner to the corresponding
+// GoogleSQL types.
+var postgreSQLTypes = map[string]string{
+	"boolean":                  "BOOL",
+	"character varying":        "STRING",
+	"text":                     "STRING",
+	"bigint":                   "INT64",
+	"real":                     "FLOAT32",
+	"double precision":         "FLOAT64",
+	"bytea":                    "BYTES",
+	"timestamp with time zone": "TIMESTAMP",
+	"date":                     "DATE",
+	"uuid":                     "UUID",
+	"interval":                 "INTERVAL",
+	"spanner.tokenlist":        "TOKENLIST",
+}
+
+// parsePostgreSQLType parses a PostgreSQL type of Spanner into a Go type based
+// on the column definition.
+func parsePostgreSQLType(dt string, nullable bool) (int, string, string) {
+	if strings.HasSuffix(dt, "[]") {
+		_, _, eleTyp := parsePostgreSQLType(strings.TrimSuffix(dt, "[]"), false)
+		typ, nilVal := "[]"+eleTyp, "nil"
+		if !nullable {
+			nilVal = typ + "{}"
+		}
+		return -1, nilVal, typ
+	}
+
+	// separate type and length from dt with length such as character varying(32)
+	length := -1
+	if m := pgLengthRegexp.FindStringSubmatchIndex(dt); m != nil {
+		l, err := strconv.Atoi(dt[m[2]:m[3]])
+		if err != nil {
+			panic("could not convert precision")
+		}
+		length = l
+		dt = dt[:m[0]]
+	}
+
+	switch dt {
+	case "numeric":
+		nilVal := "spanner.PGNumeric{Valid: true}"
+		if nullable {
+			nilVal = "spanner.PGNumeric{}"
+		}
+		return length, nilVal, "spanner.PGNumeric"
+
+	case "jsonb":
+		nilVal := "spanner.PGJsonB{Valid: true}"
+		if nullable {
+			nilVal = "spanner.PGJsonB{}"
+		}
+		return length, nilVal, "spanner.PGJsonB"
+	}
+
+	if t, ok := postgreSQLTypes[dt]; ok {
+		_, nilVal, typ := parseSpannerType(t, nullable)
+		return length, nilVal, typ
+	}
+
+	typ := internal.SnakeToCamel(strings.ReplaceAll(dt, " ", "_"))
+	return length, typ + "{}", typ
+}
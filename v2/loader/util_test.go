@@ -0,0 +1,171 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loader
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSpannerType(t *testing.T) {
+	table := []struct {
+		dataType       string
+		nullable       bool
+		expectedLen    int
+		expectedNilVal string
+		expectedType   string
+	}{
+		{"STRING(32)", false, 32, `""`, "string"},
+		{"FLOAT32", false, -1, "0.0", "float32"},
+		{"FLOAT32", true, -1, "spanner.NullFloat32{}", "spanner.NullFloat32"},
+		{"UUID", false, -1, "uuid.UUID{}", "uuid.UUID"},
+		{"UUID", true, -1, "spanner.NullUUID{}", "spanner.NullUUID"},
+		{"INTERVAL", false, -1, "spanner.Interval{}", "spanner.Interval"},
+		{"INTERVAL", true, -1, "spanner.NullInterval{}", "spanner.NullInterval"},
+		{"TOKENLIST", true, -1, "nil", "[]byte"},
+		{"ARRAY<FLOAT32>", false, -1, "[]float32{}", "[]float32"},
+		{"ARRAY<UUID>", true, -1, "nil", "[]uuid.UUID"},
+		{"ARRAY<INTERVAL>", true, -1, "nil", "[]spanner.Interval"},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.dataType, func(t *testing.T) {
+			l, nilVal, typ := parseSpannerType(tc.dataType, tc.nullable)
+			if l != tc.expectedLen {
+				t.Errorf("expected length %v, but got %v", tc.expectedLen, l)
+			}
+			if nilVal != tc.expectedNilVal {
+				t.Errorf("expected nil value %v, but got %v", tc.expectedNilVal, nilVal)
+			}
+			if typ != tc.expectedType {
+				t.Errorf("expected type %v, but got %v", tc.expectedType, typ)
+			}
+		})
+	}
+}
+
+func TestSplitVectorLength(t *testing.T) {
+	table := []struct {
+		dataType         string
+		expectedDataType string
+		expectedLength   int64
+	}{
+		{"ARRAY<FLOAT32>(vector_length=>128)", "ARRAY<FLOAT32>", 128},
+		{"ARRAY<FLOAT64>", "ARRAY<FLOAT64>", 0},
+		{"STRING(MAX)", "STRING(MAX)", 0},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.dataType, func(t *testing.T) {
+			dt, l := splitVectorLength(tc.dataType)
+			if dt != tc.expectedDataType {
+				t.Errorf("expected data type %v, but got %v", tc.expectedDataType, dt)
+			}
+			if l != tc.expectedLength {
+				t.Errorf("expected length %v, but got %v", tc.expectedLength, l)
+			}
+		})
+	}
+}
+
+func TestParsePostgreSQLType(t *testing.T) {
+	table := []struct {
+		dataType       string
+		nullable       bool
+		expectedLen    int
+		expectedNilVal string
+		expectedType   string
+	}{
+		{"bigint", false, -1, "0", "int64"},
+		{"bigint", true, -1, "spanner.NullInt64{}", "spanner.NullInt64"},
+		{"character varying(32)", false, 32, `""`, "string"},
+		{"character varying", true, -1, "spanner.NullString{}", "spanner.NullString"},
+		{"text", false, -1, `""`, "string"},
+		{"boolean", false, -1, "false", "bool"},
+		{"double precision", true, -1, "spanner.NullFloat64{}", "spanner.NullFloat64"},
+		{"real", false, -1, "0.0", "float32"},
+		{"uuid", true, -1, "spanner.NullUUID{}", "spanner.NullUUID"},
+		{"interval", false, -1, "spanner.Interval{}", "spanner.Interval"},
+		{"spanner.tokenlist", false, -1, "nil", "[]byte"},
+		{"bytea", false, -1, "nil", "[]byte"},
+		{"timestamp with time zone", false, -1, "time.Time{}", "time.Time"},
+		{"date", true, -1, "spanner.NullDate{}", "spanner.NullDate"},
+		{"numeric", false, -1, "spanner.PGNumeric{Valid: true}", "spanner.PGNumeric"},
+		{"numeric", true, -1, "spanner.PGNumeric{}", "spanner.PGNumeric"},
+		{"jsonb", false, -1, "spanner.PGJsonB{Valid: true}", "spanner.PGJsonB"},
+		{"jsonb", true, -1, "spanner.PGJsonB{}", "spanner.PGJsonB"},
+		{"bigint[]", false, -1, "[]int64{}", "[]int64"},
+		{"character varying(32)[]", true, -1, "nil", "[]string"},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.dataType, func(t *testing.T) {
+			l, nilVal, typ := parsePostgreSQLType(tc.dataType, tc.nullable)
+			if l != tc.expectedLen {
+				t.Errorf("expected length %v, but got %v", tc.expectedLen, l)
+			}
+			if nilVal != tc.expectedNilVal {
+				t.Errorf("expected nil value %v, but got %v", tc.expectedNilVal, nilVal)
+			}
+			if typ != tc.expectedType {
+				t.Errorf("expected type %v, but got %v", tc.expectedType, typ)
+			}
+		})
+	}
+}
+
+func TestParseNullableArrayType(t *testing.T) {
+	table := []struct {
+		dataType       string
+		mode           string
+		nullable       bool
+		expectedNilVal string
+		expectedType   string
+	}{
+		{"ARRAY<STRING(MAX)>", arrayElementsNullable, true, "nil", "[]spanner.NullString"},
+		{"ARRAY<NUMERIC>", arrayElementsPointer, false, "[]*big.Rat{}", "[]*big.Rat"},
+		{"ARRAY<BYTES(MAX)>", arrayElementsPointer, true, "nil", "[][]byte"},
+		{"bigint[]", arrayElementsNullable, false, "[]spanner.NullInt64{}", "[]spanner.NullInt64"},
+		{"timestamp with time zone[]", arrayElementsPointer, true, "nil", "[]*time.Time"},
+		{"numeric[]", arrayElementsPointer, true, "nil", "[]spanner.PGNumeric"},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.dataType+"/"+tc.mode, func(t *testing.T) {
+			parseType := parseSpannerType
+			if strings.HasSuffix(tc.dataType, "[]") {
+				parseType = parsePostgreSQLType
+			}
+
+			eleDataType, ok := arrayElementDataType(tc.dataType)
+			if !ok {
+				t.Fatalf("expected %v to be an array", tc.dataType)
+			}
+
+			nilVal, typ := parseNullableArrayType(parseType, eleDataType, tc.mode, tc.nullable)
+			if nilVal != tc.expectedNilVal {
+				t.Errorf("expected nil value %v, but got %v", tc.expectedNilVal, nilVal)
+			}
+			if typ != tc.expectedType {
+				t.Errorf("expected type %v, but got %v", tc.expectedType, typ)
+			}
+		})
+	}
+}
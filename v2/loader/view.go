@@ -0,0 +1,245 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loader
+
+import (
+	"strings"
+
+	"github.com/cloudspannerecosystem/memefish/ast"
+)
+
+// view is a view created by CREATE VIEW with the columns inferred from the
+// query.
+type view struct {
+	createView *ast.CreateView
+	columns    []*SpannerColumn
+}
+
+// viewSource is a table or a view in the FROM clause of a view query.
+type viewSource struct {
+	alias   string
+	columns []*SpannerColumn
+}
+
+// viewColumns infers the columns of a view from the query. Only a simple
+// SELECT from tables and views is supported. The type of an expression other than a
+// column reference, CAST, COUNT and literals can't be inferred, so such an
+// expression must be wrapped by CAST.
+//
+// All columns of a view are nullable as INFORMATION_SCHEMA reports.
+func (s *schemaParserSource) viewColumns(viewName string, query ast.QueryExpr) ([]*SpannerColumn, error) {
+	sel, ok := query.(*ast.Select)
+	if !ok {
+		return nil, newDDLError(query, "unsupported query in the view %s", viewName)
+	}
+
+	var sources []viewSource
+	if sel.From != nil {
+		var err error
+		sources, err = s.viewSources(viewName, sel.From.Source)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cols []*SpannerColumn
+	add := func(name, dataType string) {
+		cols = append(cols, &SpannerColumn{
+			FieldOrdinal: len(cols) + 1,
+			ColumnName:   name,
+			DataType:     dataType,
+		})
+	}
+
+	for _, item := range sel.Results {
+		switch item := item.(type) {
+		case *ast.Star:
+			for _, src := range sources {
+				for _, c := range src.columns {
+					if !c.IsHidden {
+						add(c.ColumnName, c.DataType)
+					}
+				}
+			}
+		case *ast.DotStar:
+			alias, ok := item.Expr.(*ast.Ident)
+			if !ok {
+				return nil, newDDLError(item, "unsupported select item %s in the view %s", item.SQL(), viewName)
+			}
+			src, ok := findViewSource(sources, alias.Name)
+			if !ok {
+				return nil, newDDLError(alias, "unknown table %s in the view %s", alias.Name, viewName)
+			}
+			for _, c := range src.columns {
+				if !c.IsHidden {
+					add(c.ColumnName, c.DataType)
+				}
+			}
+		case *ast.Alias:
+			dataType, err := viewExprType(viewName, sources, item.Expr)
+			if err != nil {
+				return nil, err
+			}
+			add(item.As.Alias.Name, dataType)
+		case *ast.ExprSelectItem:
+			var name string
+			switch expr := item.Expr.(type) {
+			case *ast.Ident:
+				name = expr.Name
+			case *ast.Path:
+				name = expr.Idents[len(expr.Idents)-1].Name
+			default:
+				return nil, newDDLError(item, "select item %s in the view %s must have an alias", item.SQL(), viewName)
+			}
+			dataType, err := viewExprType(viewName, sources, item.Expr)
+			if err != nil {
+				return nil, err
+			}
+			add(name, dataType)
+		default:
+			return nil, newDDLError(item, "unsupported select item %s in the view %s", item.SQL(), viewName)
+		}
+	}
+
+	return cols, nil
+}
+
+// viewSources collects the tables in the FROM clause of a view query.
+func (s *schemaParserSource) viewSources(viewName string, expr ast.TableExpr) ([]viewSource, error) {
+	var path *ast.Path
+	var as *ast.AsAlias
+	switch expr := expr.(type) {
+	case *ast.TableName:
+		path, as = &ast.Path{Idents: []*ast.Ident{expr.Table}}, expr.As
+	case *ast.PathTableExpr:
+		path, as = expr.Path, expr.As
+	case *ast.Join:
+		left, err := s.viewSources(viewName, expr.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := s.viewSources(viewName, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return append(left, right...), nil
+	case *ast.ParenTableExpr:
+		return s.viewSources(viewName, expr.Source)
+	default:
+		return nil, newDDLError(expr, "unsupported table %s in the view %s", expr.SQL(), viewName)
+	}
+
+	tableName, err := extractName(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []*SpannerColumn
+	if v, ok := s.views[tableName]; ok {
+		columns = v.columns
+	} else if _, ok := s.tables[tableName]; ok {
+		columns, err = s.ColumnList(tableName)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, newDDLError(path, "unknown table %s in the view %s", tableName, viewName)
+	}
+
+	alias := path.Idents[len(path.Idents)-1].Name
+	if as != nil {
+		alias = as.Alias.Name
+	}
+
+	return []viewSource{{alias: alias, columns: columns}}, nil
+}
+
+// viewExprType infers the type of an expression in a view query.
+func viewExprType(viewName string, sources []viewSource, expr ast.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		var found *SpannerColumn
+		for _, src := range sources {
+			c, ok := findViewColumn(src.columns, expr.Name)
+			if !ok {
+				continue
+			}
+			if found != nil {
+				return "", newDDLError(expr, "ambiguous column %s in the view %s", expr.Name, viewName)
+			}
+			found = c
+		}
+		if found == nil {
+			return "", newDDLError(expr, "unknown column %s in the view %s", expr.Name, viewName)
+		}
+		return found.DataType, nil
+	case *ast.Path:
+		if len(expr.Idents) != 2 {
+			return "", newDDLError(expr, "unsupported column %s in the view %s", expr.SQL(), viewName)
+		}
+		src, ok := findViewSource(sources, expr.Idents[0].Name)
+		if !ok {
+			return "", newDDLError(expr.Idents[0], "unknown table %s in the view %s", expr.Idents[0].Name, viewName)
+		}
+		c, ok := findViewColumn(src.columns, expr.Idents[1].Name)
+		if !ok {
+			return "", newDDLError(expr.Idents[1], "unknown column %s in the view %s", expr.Idents[1].Name, viewName)
+		}
+		return c.DataType, nil
+	case *ast.ParenExpr:
+		return viewExprType(viewName, sources, expr.Expr)
+	case *ast.CastExpr:
+		return expr.Type.SQL(), nil
+	case *ast.CountStarExpr:
+		return "INT64", nil
+	case *ast.CallExpr:
+		if len(expr.Func.Idents) == 1 && strings.EqualFold(expr.Func.Idents[0].Name, "COUNT") {
+			return "INT64", nil
+		}
+	case *ast.StringLiteral:
+		return "STRING", nil
+	case *ast.IntLiteral:
+		return "INT64", nil
+	case *ast.FloatLiteral:
+		return "FLOAT64", nil
+	case *ast.BoolLiteral:
+		return "BOOL", nil
+	}
+
+	return "", newDDLError(expr, "cannot infer the type of %s in the view %s, use CAST", expr.SQL(), viewName)
+}
+
+func findViewSource(sources []viewSource, alias string) (viewSource, bool) {
+	for _, src := range sources {
+		if src.alias == alias {
+			return src, true
+		}
+	}
+	return viewSource{}, false
+}
+
+func findViewColumn(columns []*SpannerColumn, name string) (*SpannerColumn, bool) {
+	for _, c := range columns {
+		if c.ColumnName == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
@@ -19,19 +19,80 @@
 
 package models
 
+// Dialect is a SQL dialect of a Spanner database.
+type Dialect string
+
+const (
+	// DialectGoogleSQL is the GoogleSQL dialect.
+	DialectGoogleSQL Dialect = "GOOGLE_STANDARD_SQL"
+	// DialectPostgreSQL is the PostgreSQL dialect.
+	DialectPostgreSQL Dialect = "POSTGRESQL"
+)
+
 // Schema contains information of all Go types.
 type Schema struct {
-	Types []*Type
+	Types          []*Type
+	Sequences      []*Sequence
+	SearchIndexes  []*SearchIndex
+	VectorIndexes  []*VectorIndex
+	PropertyGraphs []*PropertyGraph
+	Dialect        Dialect
+
+	ProtoTypes []*ProtoType // proto bundle types used by the fields
+	Imports    []string     // import specs of the Go packages of ProtoTypes and custom types
+
+	UsesUUID      bool // the fields use uuid.UUID of github.com/google/uuid
+	UsesIntArrays bool // the fields are slices of the integers which the generated code converts from and to ARRAY<INT64>
+}
+
+// ProtoType is a Go type of a protocol buffers message or enum in a proto
+// bundle.
+type ProtoType struct {
+	Name       string // fully-qualified name such as examples.shipping.Order
+	Kind       string // PROTO for a message or ENUM for an enum
+	GoType     string // Go type such as shippingpb.Order
+	ImportPath string // import path of the Go package of GoType
+}
+
+// Sequence is a sequence created by CREATE SEQUENCE.
+type Sequence struct {
+	SequenceName     string // sequence name qualified by the schema name for a named schema
+	Kind             string // sequence_kind such as bit_reversed_positive
+	SkipRangeMin     int64  // skip_range_min. 0 if not set
+	SkipRangeMax     int64  // skip_range_max. 0 if not set
+	StartWithCounter int64  // start_with_counter. 0 if not set
 }
 
 // Type is a Go type that represents a Spanner table.
 type Type struct {
-	Name             string // Go like (CamelCase) table name
-	PrimaryKeyFields []*Field
-	Fields           []*Field
-	Indexes          []*Index
-	TableName        string
-	Parent           *Type
+	Name                 string // Go like (CamelCase) table name
+	PrimaryKeyFields     []*Field
+	PrimaryKeyDirections []string // ASC or DESC of each of PrimaryKeyFields
+	Fields               []*Field
+	Indexes              []*Index
+	TableName            string  // table name qualified by the schema name for a named schema
+	SchemaName           string  // named schema of the table, empty for the default schema
+	Parent               *Type   // parent table of INTERLEAVE IN PARENT
+	Children             []*Type // tables interleaved in the table
+	OnDeleteAction       string  // CASCADE or NO ACTION for an interleaved table
+	ForeignKeys          []*ForeignKey
+	ReferencedBy         []*ForeignKey // foreign keys of other tables referencing the table
+	IsView               bool          // read-only type for a view
+
+	RowDeletionPolicy *RowDeletionPolicy // ROW DELETION POLICY of the table. nil if not defined
+	SearchIndexes     []*SearchIndex     // search indexes of the table
+	VectorIndexes     []*VectorIndex     // vector indexes of the table
+	VectorSearches    []*VectorSearch    // nearest neighbor searches on the embedding fields
+	GraphTraversals   []*GraphTraversal  // traversals from the node of the table in property graphs
+	Comment           string             // comment of the table in DDL
+}
+
+// RowDeletionPolicy is a row deletion policy (TTL) of a table. A row is
+// deleted in the background after NumDays passed since the time of Field.
+type RowDeletionPolicy struct {
+	Field             *Field // TIMESTAMP field of OLDER_THAN
+	NumDays           int64  // days of OLDER_THAN
+	FilterExpiredRows bool   // finders filter out the rows expired but not deleted yet
 }
 
 // Field is a field of Go type that represents a Spanner column.
@@ -47,6 +108,16 @@ type Field struct {
 	IsPrimaryKey    bool   // is_primary_key
 	IsGenerated     bool   // is_generated
 	IsHidden        bool   // is_hidden
+
+	AllowCommitTimestamp bool       // allow_commit_timestamp option
+	UseCommitTimestamp   bool       // spanner.CommitTimestamp is written in mutations
+	DefaultExpr          string     // default expression, empty if not defined
+	GenerationExpr       string     // expression of a generated column, empty if not generated
+	VectorLength         int64      // vector_length of an embedding column. 0 if not set
+	Sequence             *Sequence  // sequence used by the default expression. nil if not used
+	ProtoType            *ProtoType // proto bundle type of a PROTO or ENUM column. nil for other types
+	CustomTypeImportPath string     // import path of the package of the custom type. Empty if not qualified by an import path
+	Comment              string     // comment of the column in DDL
 }
 
 // Index is a template item for a index into a table.
@@ -58,7 +129,104 @@ type Index struct {
 	Fields         []*Field
 	StoringFields  []*Field
 	NullableFields []*Field
-	IndexName      string // index name
-	IsUnique       bool   // the index is unique ro not
-	IsPrimary      bool   // the index is primary key or not
+	IndexName      string   // index name
+	IsUnique       bool     // the index is unique ro not
+	IsPrimary      bool     // the index is primary key or not
+	IsNullFiltered bool     // the index is NULL_FILTERED or not
+	KeyDirections  []string // ASC or DESC of each of Fields
+
+	ParentTableName string // table which the index is interleaved in. Empty if not interleaved
+	Parent          *Type  // type of the table which the index is interleaved in. nil if not loaded
+	Comment         string // comment of the index in DDL
+}
+
+// SearchIndex is a search index created by CREATE SEARCH INDEX.
+type SearchIndex struct {
+	Name          string // Go like (CamelCase) index name
+	IndexName     string // index name
+	Type          *Type
+	Fields        []*Field  // TOKENLIST fields of the index
+	StoringFields []*Field  // storing fields of the index
+	Searches      []*Search // full-text searches on Fields
+	Comment       string    // comment of the search index in DDL
+}
+
+// Search is a full-text search on a TOKENLIST field of a search index which is
+// tokenized from a STRING field by TOKENIZE_FULLTEXT.
+type Search struct {
+	FuncName    string // `Search` + pluralized Type name + `By` + SourceField name
+	Index       *SearchIndex
+	Field       *Field // TOKENLIST field
+	SourceField *Field // STRING field tokenized into Field
+}
+
+// VectorIndex is a vector index created by CREATE VECTOR INDEX.
+type VectorIndex struct {
+	Name         string // Go like (CamelCase) index name
+	IndexName    string // index name
+	Type         *Type
+	Field        *Field // embedding field of the index
+	DistanceType string // COSINE, EUCLIDEAN or DOT_PRODUCT
+	Comment      string // comment of the vector index in DDL
+}
+
+// VectorSearch is a nearest neighbor search on an embedding field. The search
+// is approximate with a vector index and exact without it.
+type VectorSearch struct {
+	FuncName     string // `FindNearest` + pluralized Type name + `By` + Field name
+	Type         *Type
+	Field        *Field       // embedding field with vector_length
+	Index        *VectorIndex // vector index on Field. nil for an exact search
+	VectorType   string       // Go type of the query vector. []float32 or []float64
+	DistanceFunc string       // distance function such as APPROX_COSINE_DISTANCE
+	Desc         bool         // the nearest rows have the largest distance values, i.e. DOT_PRODUCT
+}
+
+// PropertyGraph is a property graph created by CREATE PROPERTY GRAPH.
+type PropertyGraph struct {
+	Name      string          // Go like (CamelCase) graph name
+	GraphName string          // property graph name
+	Nodes     []*GraphElement // node tables
+	Edges     []*GraphElement // edge tables
+	Comment   string          // comment of the property graph in DDL
+}
+
+// GraphElement is a node or edge table of a property graph.
+type GraphElement struct {
+	Name       string // element name, which is the alias or the table name
+	Graph      *PropertyGraph
+	Type       *Type
+	Labels     []string // labels of the element
+	Label      string   // label to match a node in a query. It is unique among the nodes if possible
+	KeyFields  []*Field // key of the element
+	Properties []*Field // fields exposed as the properties of the same names
+
+	Source            *GraphElement // source node of an edge. nil for a node
+	SourceFields      []*Field      // fields of an edge referencing the source node
+	Destination       *GraphElement // destination node of an edge. nil for a node
+	DestinationFields []*Field      // fields of an edge referencing the destination node
+}
+
+// GraphTraversal is a traversal from a node over the edges of a label to the
+// adjacent nodes in a property graph.
+type GraphTraversal struct {
+	FuncName string // `Find` + `Outgoing` or `Incoming` + Label + pluralized To Type name
+	Graph    *PropertyGraph
+	Label    string        // label of the edges
+	Edge     *GraphElement // edge table which defines the traversal
+	From     *GraphElement // node which the traversal starts from
+	To       *GraphElement // adjacent nodes
+	Incoming bool          // the edges are from To to From
+}
+
+// ForeignKey is a template item for a foreign key from a table to a referenced table.
+type ForeignKey struct {
+	Name           string // constraint name. It may be empty for an unnamed foreign key in DDL
+	FuncName       string // referenced Type name
+	RefFuncName    string // pluralized Type name
+	Type           *Type
+	Fields         []*Field
+	RefType        *Type
+	RefFields      []*Field
+	OnDeleteAction string // CASCADE or NO ACTION
 }
@@ -9,11 +9,26 @@ package {{ .Package }}
 
 import (
 	"context"
+{{- if .Schema.ProtoTypes }}
+	"encoding/base64"
+{{- end }}
 	"errors"
 	"fmt"
 
 	"cloud.google.com/go/spanner"
+{{- if .Schema.UsesUUID }}
+	"github.com/google/uuid"
+{{- end }}
 	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+{{- if .Schema.ProtoTypes }}
+	"google.golang.org/protobuf/proto"
+{{- end }}
+{{- if .Schema.UsesIntArrays }}
+	"google.golang.org/protobuf/types/known/structpb"
+{{- end }}
+{{- range .Schema.Imports }}
+	{{ . }}
+{{- end }}
 )
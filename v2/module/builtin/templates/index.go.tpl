@@ -1,11 +1,19 @@
 {{- range .Indexes }}
 {{- $short := (shortName .Type.Name "err" "sqlstr" "db" "q" "res" "YOLog" .Fields) -}}
 {{- $table := (.Type.TableName) -}}
+{{- $filterExpired := (and .Type.RowDeletionPolicy .Type.RowDeletionPolicy.FilterExpiredRows) -}}
 
 {{- if not .IsUnique }}
 // Find{{ .FuncName }} retrieves multiple rows from '{{ $table }}' as a slice of {{ .Type.Name }}.
 //
 // Generated from index '{{ .IndexName }}'.
+{{- if .Parent }}
+// The index is interleaved in '{{ .Parent.TableName }}'.
+{{- end }}
+{{- if .Comment }}
+//
+{{ docComment .Comment "" }}
+{{- end }}
 func Find{{ .FuncName }}(ctx context.Context, db YODB{{ goParams .Fields true true }}) ([]*{{ .Type.Name }}, error) {
 {{- else }}
 // Find{{ .FuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.
@@ -14,37 +22,53 @@ func Find{{ .FuncName }}(ctx context.Context, db YODB{{ goParams .Fields true tr
 // spanner.ErrCode(err) is codes.NotFound.
 //
 // Generated from unique index '{{ .IndexName }}'.
+{{- if .Parent }}
+// The index is interleaved in '{{ .Parent.TableName }}'.
+{{- end }}
+{{- if .Comment }}
+//
+{{ docComment .Comment "" }}
+{{- end }}
 func Find{{ .FuncName }}(ctx context.Context, db YODB{{ goParams .Fields true true }}) (*{{ .Type.Name }}, error) {
 {{- end }}
-	{{- if not .NullableFields }}
+	{{- if or (not .NullableFields) .IsNullFiltered }}
 	const sqlstr = "SELECT " +
 		"{{ columnNamesWithoutHidden .Type.Fields }} " +
-		"FROM {{ $table }}@{FORCE_INDEX={{ .IndexName }}} " +
-		"WHERE {{ columnNamesQuery .Fields " AND " }}"
+		"FROM {{ forceIndex $table .IndexName }} " +
+		"WHERE {{ columnNamesQuery .Fields " AND " }}{{ if $filterExpired }} AND {{ notExpiredQuery .Type (len .Fields) }}{{ end }}{{ if not .IsUnique }} ORDER BY {{ orderBy . }}{{ end }}"
 	{{- else }}
 	var sqlstr = "SELECT " +
 		"{{ columnNamesWithoutHidden .Type.Fields }} " +
-		"FROM {{ $table }}@{FORCE_INDEX={{ .IndexName }}} "
+		"FROM {{ forceIndex $table .IndexName }} "
 
 	conds := make([]string, {{ len .Fields }})
 	{{- range $i, $f := .Fields }}
 	{{- if $f.IsNotNull }}
-		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = @param{{ $i }}"
+		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = {{ nthParam $i }}"
 	{{- else }}
 	if {{ nullcheck $f }} {
 		conds[{{ $i }}] = "{{ escape $f.ColumnName }} IS NULL"
 	} else {
-		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = @param{{ $i }}"
+		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = {{ nthParam $i }}"
 	}
 	{{- end }}
 	{{- end }}
 	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+	{{- if $filterExpired }}
+	sqlstr += " AND {{ notExpiredQuery .Type (len .Fields) }}"
+	{{- end }}
+	{{- if not .IsUnique }}
+	sqlstr += " ORDER BY {{ orderBy . }}"
+	{{- end }}
 	{{- end }}
 
 	stmt := spanner.NewStatement(sqlstr)
 	{{- range $i, $f := .Fields }}
-		stmt.Params["param{{ $i }}"] = {{ goEncodedParam $f.Name }}
+		stmt.Params["{{ paramName $i }}"] = {{ goEncodedParam $f.Name }}
 	{{- end}}
+	{{- if $filterExpired }}
+		stmt.Params["{{ paramName (len .Fields) }}"] = time.Now().Add(-{{ .Type.Name }}Retention)
+	{{- end }}
 
 
 	decoder := new{{ .Type.Name }}_Decoder({{ .Type.Name }}Columns())
@@ -96,6 +120,23 @@ func Find{{ .FuncName }}(ctx context.Context, db YODB{{ goParams .Fields true tr
 {{- end }}
 }
 
+{{- if .Parent }}
+{{- $pshort := (shortName .Parent.Name "err" "sqlstr" "db" "q" "res" "YOLog" .Fields) }}
+{{- $keys := slice .Fields (len .Parent.PrimaryKeyFields) }}
+
+// Find{{ .FuncName }} retrieves {{ if .IsUnique }}a row{{ else }}multiple rows{{ end }} from '{{ $table }}' interleaved in the {{ .Parent.Name }}
+// by the rest of the index keys.
+//
+// Generated from {{ if .IsUnique }}unique {{ end }}index '{{ .IndexName }}'.
+{{- if .Comment }}
+//
+{{ docComment .Comment "" }}
+{{- end }}
+func ({{ $pshort }} *{{ .Parent.Name }}) Find{{ .FuncName }}(ctx context.Context, db YODB{{ goParams $keys true true }}) ({{ if not .IsUnique }}[]{{ end }}*{{ .Type.Name }}, error) {
+	return Find{{ .FuncName }}(ctx, db{{ range .Parent.PrimaryKeyFields }}, {{ $pshort }}.{{ .Name }}{{ end }}{{ goParams $keys true false }})
+}
+{{- end }}
+
 
 // Read{{ .FuncName }} retrieves multiples rows from '{{ $table }}' by KeySet as a slice.
 //
@@ -111,8 +152,10 @@ func Read{{ .FuncName }}(ctx context.Context, db YODB, keys spanner.KeySet) ([]*
 		"{{ .ColumnName }}",
 {{- end }}
 {{- range .Fields }}
+{{- if not .IsPrimaryKey }}
 		"{{ .ColumnName }}",
 {{- end }}
+{{- end }}
 {{- range .StoringFields }}
 		"{{ .ColumnName }}",
 {{- end }}
@@ -126,6 +169,14 @@ func Read{{ .FuncName }}(ctx context.Context, db YODB, keys spanner.KeySet) ([]*
 		if err != nil {
 			return err
 		}
+{{- if $filterExpired }}
+{{- $policy := .Type.RowDeletionPolicy.Field.Name }}
+{{- if or (hasField .Type.PrimaryKeyFields $policy) (hasField .Fields $policy) (hasField .StoringFields $policy) }}
+		if {{ $short }}.IsExpired(time.Now()) {
+			return nil
+		}
+{{- end }}
+{{- end }}
 		res = append(res, {{ $short }})
 
 		return nil
@@ -137,3 +188,180 @@ func Read{{ .FuncName }}(ctx context.Context, db YODB, keys spanner.KeySet) ([]*
     return res, nil
 }
 {{- end }}
+
+{{- $type := . }}
+{{- range .SearchIndexes }}
+{{- $ix := . }}
+{{- range .Searches }}
+{{- $table := $type.TableName -}}
+{{- $filterExpired := (and $type.RowDeletionPolicy $type.RowDeletionPolicy.FilterExpiredRows) }}
+
+// {{ .FuncName }} runs a full-text search of query on '{{ .SourceField.ColumnName }}' of '{{ $table }}'
+// and retrieves the matched rows as a slice of {{ $type.Name }}SearchResult.
+//
+// Generated from search index '{{ $ix.IndexName }}' on '{{ .Field.ColumnName }}'.
+{{- if $ix.Comment }}
+//
+{{ docComment $ix.Comment "" }}
+{{- end }}
+func {{ .FuncName }}(ctx context.Context, db YODB, query string, opts *YOSearchOptions) ([]*{{ $type.Name }}SearchResult, error) {
+	if opts == nil {
+		opts = &YOSearchOptions{}
+	}
+
+	args := "{{ escape .Field.ColumnName }}, {{ nthParam 0 }}"
+	if opts.EnhanceQuery {
+		args += ", enhance_query => TRUE"
+	}
+
+	sqlstr := "SELECT " +
+		"{{ columnNamesWithoutHidden $type.Fields }}, " +
+		"SCORE(" + args + ")"
+	if opts.Snippet {
+		sqlstr += ", SNIPPET({{ escape .SourceField.ColumnName }}, {{ nthParam 0 }}"
+		if opts.EnhanceQuery {
+			sqlstr += ", enhance_query => TRUE"
+		}
+		sqlstr += ")"
+	}
+	sqlstr += " FROM {{ forceIndex $table $ix.IndexName }} " +
+		"WHERE SEARCH(" + args + ")"
+	{{- if $filterExpired }}
+	sqlstr += " AND {{ notExpiredQuery $type 2 }}"
+	{{- end }}
+	if opts.OrderByScore {
+		sqlstr += " ORDER BY SCORE(" + args + ") DESC"
+	}
+	if opts.Limit > 0 {
+		sqlstr += " LIMIT {{ nthParam 1 }}"
+	}
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["{{ paramName 0 }}"] = query
+	if opts.Limit > 0 {
+		stmt.Params["{{ paramName 1 }}"] = opts.Limit
+	}
+	{{- if $filterExpired }}
+	stmt.Params["{{ paramName 2 }}"] = time.Now().Add(-{{ $type.Name }}Retention)
+	{{- end }}
+
+	// run query
+	YOLog(ctx, sqlstr, query)
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ $type.Name }}SearchResult{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		r := &{{ $type.Name }}SearchResult{ {{- $type.Name }}: &{{ $type.Name }}{}}
+		ptrs, err := r.{{ $type.Name }}.columnsToPtrs({{ $type.Name }}Columns())
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ .FuncName }}", "{{ $table }}", err)
+		}
+		ptrs = append(ptrs, &r.Score)
+		if opts.Snippet {
+			ptrs = append(ptrs, &r.Snippet)
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- end }}
+
+{{- if .SearchIndexes }}
+
+// {{ .Name }}SearchResult is a row of {{ .Name }} found by a full-text search.
+type {{ .Name }}SearchResult struct {
+	{{ .Name }} *{{ .Name }}
+	Score   float64          // SCORE of the search query
+	Snippet spanner.NullJSON // SNIPPET of the search query. NULL unless YOSearchOptions.Snippet is set
+}
+{{- end }}
+
+{{- range .VectorSearches }}
+{{- $table := .Type.TableName -}}
+{{- $filterExpired := (and .Type.RowDeletionPolicy .Type.RowDeletionPolicy.FilterExpiredRows) }}
+
+// {{ .FuncName }} retrieves the k nearest rows to vec from '{{ $table }}'
+// by {{ .DistanceFunc }} of '{{ .Field.ColumnName }}' as a slice of {{ .Type.Name }}.
+//
+{{- if .Index }}
+// Generated from vector index '{{ .Index.IndexName }}'.
+{{- if .Index.Comment }}
+//
+{{ docComment .Index.Comment "" }}
+{{- end }}
+{{- else }}
+// This is an exact nearest neighbor search because '{{ .Field.ColumnName }}' has no vector index.
+{{- end }}
+func {{ .FuncName }}(ctx context.Context, db YODB, vec {{ .VectorType }}, k int64) ([]*{{ .Type.Name }}, error) {
+	if len(vec) != {{ .Field.VectorLength }} {
+		return nil, newErrorWithCode(codes.InvalidArgument, "{{ .FuncName }}", "{{ $table }}", fmt.Errorf("vector length must be {{ .Field.VectorLength }}, but got %d", len(vec)))
+	}
+{{ if .Index }}
+	sqlstr := "SELECT " +
+		"{{ columnNamesWithoutHidden .Type.Fields }} " +
+		"FROM {{ forceIndex $table .Index.IndexName }} " +
+		"WHERE {{ escape .Field.ColumnName }} IS NOT NULL{{ if $filterExpired }} AND {{ notExpiredQuery .Type 2 }}{{ end }} " +
+		"ORDER BY {{ .DistanceFunc }}({{ escape .Field.ColumnName }}, {{ nthParam 0 }}, " +
+		fmt.Sprintf(`options => JSON '{"num_leaves_to_search": %d}'`, YONumLeavesToSearch) +
+		"){{ if .Desc }} DESC{{ end }} LIMIT {{ nthParam 1 }}"
+	{{- else }}
+	const sqlstr = "SELECT " +
+		"{{ columnNamesWithoutHidden .Type.Fields }} " +
+		"FROM {{ escape $table }} " +
+		"WHERE {{ escape .Field.ColumnName }} IS NOT NULL{{ if $filterExpired }} AND {{ notExpiredQuery .Type 2 }}{{ end }} " +
+		"ORDER BY {{ .DistanceFunc }}({{ escape .Field.ColumnName }}, {{ nthParam 0 }}){{ if .Desc }} DESC{{ end }} LIMIT {{ nthParam 1 }}"
+	{{- end }}
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["{{ paramName 0 }}"] = vec
+	stmt.Params["{{ paramName 1 }}"] = k
+	{{- if $filterExpired }}
+	stmt.Params["{{ paramName 2 }}"] = time.Now().Add(-{{ .Type.Name }}Retention)
+	{{- end }}
+
+	decoder := new{{ .Type.Name }}_Decoder({{ .Type.Name }}Columns())
+
+	// run query
+	YOLog(ctx, sqlstr, vec, k)
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ .Type.Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		r, err := decoder(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+{{- end }}
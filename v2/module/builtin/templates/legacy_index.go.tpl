@@ -1,11 +1,19 @@
 {{- range .Indexes }}
 {{- $short := (shortName .Type.Name "err" "sqlstr" "db" "q" "res" "YOLog" .Fields) -}}
 {{- $table := (.Type.TableName) -}}
+{{- $filterExpired := (and .Type.RowDeletionPolicy .Type.RowDeletionPolicy.FilterExpiredRows) -}}
 
 {{- if not .IsUnique }}
 // Find{{ .LegacyFuncName }} retrieves multiple rows from '{{ $table }}' as a slice of {{ .Type.Name }}.
 //
 // Generated from index '{{ .IndexName }}'.
+{{- if .Parent }}
+// The index is interleaved in '{{ .Parent.TableName }}'.
+{{- end }}
+{{- if .Comment }}
+//
+{{ docComment .Comment "" }}
+{{- end }}
 func Find{{ .LegacyFuncName }}(ctx context.Context, db YODB{{ goParams .Fields true true }}) ([]*{{ .Type.Name }}, error) {
 {{- else }}
 // Find{{ .LegacyFuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.
@@ -14,37 +22,53 @@ func Find{{ .LegacyFuncName }}(ctx context.Context, db YODB{{ goParams .Fields t
 // spanner.ErrCode(err) is codes.NotFound.
 //
 // Generated from unique index '{{ .IndexName }}'.
+{{- if .Parent }}
+// The index is interleaved in '{{ .Parent.TableName }}'.
+{{- end }}
+{{- if .Comment }}
+//
+{{ docComment .Comment "" }}
+{{- end }}
 func Find{{ .LegacyFuncName }}(ctx context.Context, db YODB{{ goParams .Fields true true }}) (*{{ .Type.Name }}, error) {
 {{- end }}
-	{{- if not .NullableFields }}
+	{{- if or (not .NullableFields) .IsNullFiltered }}
 	const sqlstr = "SELECT " +
 		"{{ columnNames .Type.Fields }} " +
-		"FROM {{ $table }}@{FORCE_INDEX={{ .IndexName }}} " +
-		"WHERE {{ columnNamesQuery .Fields " AND " }}"
+		"FROM {{ forceIndex $table .IndexName }} " +
+		"WHERE {{ columnNamesQuery .Fields " AND " }}{{ if $filterExpired }} AND {{ notExpiredQuery .Type (len .Fields) }}{{ end }}{{ if not .IsUnique }} ORDER BY {{ orderBy . }}{{ end }}"
 	{{- else }}
 	var sqlstr = "SELECT " +
 		"{{ columnNames .Type.Fields }} " +
-		"FROM {{ $table }}@{FORCE_INDEX={{ .IndexName }}} "
+		"FROM {{ forceIndex $table .IndexName }} "
 
 	conds := make([]string, {{ len .Fields }})
 	{{- range $i, $f := .Fields }}
 	{{- if $f.IsNotNull }}
-		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = @param{{ $i }}"
+		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = {{ nthParam $i }}"
 	{{- else }}
 	if {{ nullcheck $f }} {
 		conds[{{ $i }}] = "{{ escape $f.ColumnName }} IS NULL"
 	} else {
-		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = @param{{ $i }}"
+		conds[{{ $i }}] = "{{ escape $f.ColumnName }} = {{ nthParam $i }}"
 	}
 	{{- end }}
 	{{- end }}
 	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+	{{- if $filterExpired }}
+	sqlstr += " AND {{ notExpiredQuery .Type (len .Fields) }}"
+	{{- end }}
+	{{- if not .IsUnique }}
+	sqlstr += " ORDER BY {{ orderBy . }}"
+	{{- end }}
 	{{- end }}
 
 	stmt := spanner.NewStatement(sqlstr)
 	{{- range $i, $f := .Fields }}
-		stmt.Params["param{{ $i }}"] = {{ goEncodedParam $f.Name }}
+		stmt.Params["{{ paramName $i }}"] = {{ goEncodedParam $f.Name }}
 	{{- end}}
+	{{- if $filterExpired }}
+		stmt.Params["{{ paramName (len .Fields) }}"] = time.Now().Add(-{{ .Type.Name }}Retention)
+	{{- end }}
 
 
 	decoder := new{{ .Type.Name }}_Decoder({{ .Type.Name }}Columns())
@@ -111,8 +135,10 @@ func Read{{ .LegacyFuncName }}(ctx context.Context, db YODB, keys spanner.KeySet
 		"{{ .ColumnName }}",
 {{- end }}
 {{- range .Fields }}
+{{- if not .IsPrimaryKey }}
 		"{{ .ColumnName }}",
 {{- end }}
+{{- end }}
 {{- range .StoringFields }}
 		"{{ .ColumnName }}",
 {{- end }}
@@ -126,6 +152,14 @@ func Read{{ .LegacyFuncName }}(ctx context.Context, db YODB, keys spanner.KeySet
 		if err != nil {
 			return err
 		}
+{{- if $filterExpired }}
+{{- $policy := .Type.RowDeletionPolicy.Field.Name }}
+{{- if or (hasField .Type.PrimaryKeyFields $policy) (hasField .Fields $policy) (hasField .StoringFields $policy) }}
+		if {{ $short }}.IsExpired(time.Now()) {
+			return nil
+		}
+{{- end }}
+{{- end }}
 		res = append(res, {{ $short }})
 
 		return nil
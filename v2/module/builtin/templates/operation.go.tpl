@@ -1,18 +1,174 @@
 {{- $short := (shortName .Name "err" "res" "sqlstr" "db" "YOLog") -}}
 {{- $table := (.TableName) -}}
+{{- $commitTimestamps := (commitTimestampFields .Fields) -}}
+{{- $defaults := (defaultFields .Fields) -}}
+{{- $sequences := (sequenceFields .Fields) -}}
+{{- $returningDefaults := (defaultFields (insertReturningFields .Fields)) -}}
+{{- if .IsView -}}
+// Find{{ pluralize .Name }} retrieves rows from the view '{{ $table }}' as a slice of {{ .Name }}.
+// If where is not empty, it is added to the query as a WHERE clause. params
+// are the query parameters referenced in where.
+func Find{{ pluralize .Name }}(ctx context.Context, db YODB, where string, params map[string]interface{}) ([]*{{ .Name }}, error) {
+	sqlstr := "SELECT " +
+		"{{ columnNamesWithoutHidden .Fields }} " +
+		"FROM {{ escape $table }}"
+	if where != "" {
+		sqlstr += " WHERE " + where
+	}
+
+	stmt := spanner.Statement{SQL: sqlstr, Params: params}
+
+	decoder := new{{ .Name }}_Decoder({{ .Name }}Columns())
+
+	// run query
+	YOLog(ctx, sqlstr, params)
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ .Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("Find{{ pluralize .Name }}", "{{ $table }}", err)
+		}
+
+		{{ $short }}, err := decoder(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "Find{{ pluralize .Name }}", "{{ $table }}", err)
+		}
+
+		res = append(res, {{ $short }})
+	}
+
+	return res, nil
+}
+{{- else -}}
 
 // Insert returns a Mutation to insert a row into a table. If the row already
 // exists, the write or transaction fails.
 func ({{ $short }} *{{ .Name }}) Insert(ctx context.Context) *spanner.Mutation {
 	values, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())
+{{- if $commitTimestamps }}
+	{{ $short }}.setCommitTimestamp({{ .Name }}WritableColumns(), values)
+{{- end }}
 	return spanner.Insert("{{ $table }}", {{ .Name }}WritableColumns(), values)
 }
+{{- if $defaults }}
+
+// InsertWithDefaults returns a Mutation to insert a row into a table. The
+// columns in omit are omitted, so that the default values are used. Only the
+// columns with a default value can be omitted, and the other columns in omit
+// are ignored. If the row already exists, the write or transaction fails.
+func ({{ $short }} *{{ .Name }}) InsertWithDefaults(ctx context.Context, omit ...string) *spanner.Mutation {
+	omitted := make(map[string]bool, len(omit))
+	for _, col := range omit {
+		omitted[col] = true
+	}
+
+	cols := make([]string, 0, len({{ .Name }}WritableColumns()))
+	for _, col := range {{ .Name }}WritableColumns() {
+		switch col {
+		case {{ range $i, $f := $defaults }}{{ if $i }}, {{ end }}"{{ $f.ColumnName }}"{{ end }}:
+			if omitted[col] {
+				continue
+			}
+		}
+		cols = append(cols, col)
+	}
+
+	values, _ := {{ $short }}.columnsToValues(cols)
+{{- if $commitTimestamps }}
+	{{ $short }}.setCommitTimestamp(cols, values)
+{{- end }}
+	return spanner.Insert("{{ $table }}", cols, values)
+}
+{{- end }}
+{{- if $commitTimestamps }}
+
+// setCommitTimestamp sets spanner.CommitTimestamp to the values of the commit
+// timestamp columns in cols.
+func ({{ $short }} *{{ .Name }}) setCommitTimestamp(cols []string, values []interface{}) {
+	for i, col := range cols {
+		switch col {
+		case {{ range $i, $f := $commitTimestamps }}{{ if $i }}, {{ end }}"{{ $f.ColumnName }}"{{ end }}:
+			values[i] = spanner.CommitTimestamp
+		}
+	}
+}
+{{- end }}
+{{- if $sequences }}
+
+// InsertReturning inserts a row into a table by DML in the read-write
+// transaction, and sets the values assigned by the sequences to
+// {{ range $i, $f := $sequences }}{{ if $i }}, {{ end }}{{ $f.Name }}{{ end }}. If the row already exists, the statement fails.
+{{- if $returningDefaults }}
+// The columns in omit are omitted, so that the default values are used. Only
+// the columns with a default value can be omitted, and the other columns in
+// omit are ignored.
+func ({{ $short }} *{{ .Name }}) InsertReturning(ctx context.Context, txn *spanner.ReadWriteTransaction, omit ...string) error {
+	omitted := make(map[string]bool, len(omit))
+	for _, col := range omit {
+		omitted[col] = true
+	}
+
+	cols := make([]string, 0, {{ len .Fields }})
+	values := make([]string, 0, {{ len .Fields }})
+	stmt := spanner.Statement{Params: make(map[string]interface{})}
+{{ range $i, $f := (insertReturningFields .Fields) }}
+	{{- if $f.DefaultExpr }}
+	if !omitted["{{ $f.ColumnName }}"] {
+		cols = append(cols, "{{ escape $f.ColumnName }}")
+		values = append(values, "{{ nthParam $i }}")
+		stmt.Params["{{ paramName $i }}"] = yoEncode({{ $short }}.{{ $f.Name }})
+	}
+	{{- else }}
+	cols = append(cols, "{{ escape $f.ColumnName }}")
+	values = append(values, "{{ nthParam $i }}")
+	stmt.Params["{{ paramName $i }}"] = yoEncode({{ $short }}.{{ $f.Name }})
+	{{- end }}
+	{{- end }}
+	{{- range $commitTimestamps }}
+	cols = append(cols, "{{ escape .ColumnName }}")
+	values = append(values, "{{ pendingCommitTimestamp }}")
+	{{- end }}
+
+	sqlstr := "INSERT INTO {{ escape $table }} (" + strings.Join(cols, ", ") + ") " +
+		"VALUES (" + strings.Join(values, ", ") + ") {{ returningClause . }}"
+	stmt.SQL = sqlstr
+{{- else }}
+func ({{ $short }} *{{ .Name }}) InsertReturning(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+	const sqlstr = "{{ insertReturningQuery . }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := (insertReturningFields .Fields) }}
+	stmt.Params["{{ paramName $i }}"] = yoEncode({{ $short }}.{{ $f.Name }})
+	{{- end }}
+{{- end }}
+
+	YOLog(ctx, sqlstr{{ range (insertReturningFields .Fields) }}, {{ $short }}.{{ .Name }}{{ end }})
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		return row.Columns({{ range $i, $f := $sequences }}{{ if $i }}, {{ end }}yoDecode(&{{ $short }}.{{ $f.Name }}){{ end }})
+	})
+	if err != nil {
+		return newError("{{ .Name }}.InsertReturning", "{{ $table }}", err)
+	}
+
+	return nil
+}
+{{- end }}
 
 {{ if ne (len .Fields) (len .PrimaryKeyFields) }}
 // Update returns a Mutation to update a row in a table. If the row does not
 // already exist, the write or transaction fails.
 func ({{ $short }} *{{ .Name }}) Update(ctx context.Context) *spanner.Mutation {
 	values, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())
+{{- if $commitTimestamps }}
+	{{ $short }}.setCommitTimestamp({{ .Name }}WritableColumns(), values)
+{{- end }}
 	return spanner.Update("{{ $table }}", {{ .Name }}WritableColumns(), values)
 }
 
@@ -21,6 +177,9 @@ func ({{ $short }} *{{ .Name }}) Update(ctx context.Context) *spanner.Mutation {
 // written are preserved.
 func ({{ $short }} *{{ .Name }}) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
 	values, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())
+{{- if $commitTimestamps }}
+	{{ $short }}.setCommitTimestamp({{ .Name }}WritableColumns(), values)
+{{- end }}
 	return spanner.InsertOrUpdate("{{ $table }}", {{ .Name }}WritableColumns(), values)
 }
 
@@ -29,6 +188,9 @@ func ({{ $short }} *{{ .Name }}) InsertOrUpdate(ctx context.Context) *spanner.Mu
 // written become NULL.
 func ({{ $short }} *{{ .Name }}) Replace(ctx context.Context) *spanner.Mutation {
 	values, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())
+{{- if $commitTimestamps }}
+	{{ $short }}.setCommitTimestamp({{ .Name }}WritableColumns(), values)
+{{- end }}
 	return spanner.Replace("{{ $table }}", {{ .Name }}WritableColumns(), values)
 }
 
@@ -59,6 +221,15 @@ func Find{{ .Name }}(ctx context.Context, db YODB{{ goParams .PrimaryKeyFields t
 		return nil, newErrorWithCode(codes.Internal, "Find{{ .Name }}", "{{ $table }}", err)
 	}
 
+{{- with .RowDeletionPolicy }}
+{{- if .FilterExpiredRows }}
+
+	if {{ $short }}.IsExpired(time.Now()) {
+		return nil, newErrorWithCode(codes.NotFound, "Find{{ $.Name }}", "{{ $table }}", fmt.Errorf("the row is expired by the row deletion policy"))
+	}
+{{- end }}
+{{- end }}
+
 	return {{ $short }}, nil
 }
 
@@ -74,6 +245,13 @@ func Read{{ .Name }}(ctx context.Context, db YODB, keys spanner.KeySet) ([]*{{ .
 		if err != nil {
 			return err
 		}
+{{- with .RowDeletionPolicy }}
+{{- if .FilterExpiredRows }}
+		if {{ $short }}.IsExpired(time.Now()) {
+			return nil
+		}
+{{- end }}
+{{- end }}
 		res = append(res, {{ $short }})
 
 		return nil
@@ -85,9 +263,196 @@ func Read{{ .Name }}(ctx context.Context, db YODB, keys spanner.KeySet) ([]*{{ .
 	return res, nil
 }
 {{ end }}
+{{- if .Children }}
+{{- $parent := . }}
+
+// KeyRange returns a KeyRange that matches all rows whose primary key starts
+// with the primary key of the {{ .Name }}. It covers the rows interleaved in the {{ .Name }}.
+func ({{ $short }} *{{ .Name }}) KeyRange() spanner.KeyRange {
+	values, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())
+	return spanner.KeyRange{
+		Start: spanner.Key(values),
+		End:   spanner.Key(values),
+		Kind:  spanner.ClosedClosed,
+	}
+}
+{{- range .Children }}
+
+// Read{{ pluralize .Name }} retrieves all {{ .Name }} rows interleaved in the {{ $parent.Name }}.
+func ({{ $short }} *{{ $parent.Name }}) Read{{ pluralize .Name }}(ctx context.Context, db YODB) ([]*{{ .Name }}, error) {
+	var res []*{{ .Name }}
+
+	decoder := new{{ .Name }}_Decoder({{ .Name }}Columns())
+
+	rows := db.Read(ctx, "{{ .TableName }}", {{ $short }}.KeyRange(), {{ .Name }}Columns())
+	err := rows.Do(func(row *spanner.Row) error {
+		child, err := decoder(row)
+		if err != nil {
+			return err
+		}
+{{- with .RowDeletionPolicy }}
+{{- if .FilterExpiredRows }}
+		if child.IsExpired(time.Now()) {
+			return nil
+		}
+{{- end }}
+{{- end }}
+		res = append(res, child)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "{{ $parent.Name }}.Read{{ pluralize .Name }}", "{{ .TableName }}", err)
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- end }}
+{{- range .ForeignKeys }}
+
+// Find{{ .FuncName }} retrieves the {{ .RefType.Name }} referenced by the {{ .Type.Name }}
+// through the foreign key{{ if .Name }} '{{ .Name }}'{{ end }}.
+//
+// If no row is present, then it returns an error where spanner.ErrCode(err)
+// is codes.NotFound.
+func ({{ $short }} *{{ .Type.Name }}) Find{{ .FuncName }}(ctx context.Context, db YODB) (*{{ .RefType.Name }}, error) {
+	const sqlstr = "SELECT " +
+		"{{ columnNamesWithoutHidden .RefType.Fields }} " +
+		"FROM {{ escape .RefType.TableName }} " +
+		"WHERE {{ columnNamesQuery .RefFields " AND " }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .Fields }}
+	stmt.Params["{{ paramName $i }}"] = yoEncode({{ $short }}.{{ $f.Name }})
+	{{- end }}
+
+	decoder := new{{ .RefType.Name }}_Decoder({{ .RefType.Name }}Columns())
+
+	// run query
+	YOLog(ctx, sqlstr, {{ fieldNames .Fields $short }})
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newErrorWithCode(codes.NotFound, "{{ .Type.Name }}.Find{{ .FuncName }}", "{{ .RefType.TableName }}", err)
+		}
+		return nil, newError("{{ .Type.Name }}.Find{{ .FuncName }}", "{{ .RefType.TableName }}", err)
+	}
+
+	ref, err := decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "{{ .Type.Name }}.Find{{ .FuncName }}", "{{ .RefType.TableName }}", err)
+	}
+
+	return ref, nil
+}
+{{- end }}
+{{- range .ReferencedBy }}
+
+// Find{{ .RefFuncName }} retrieves the {{ .Type.Name }} rows referencing the {{ .RefType.Name }}
+// through the foreign key{{ if .Name }} '{{ .Name }}'{{ end }}.
+func ({{ $short }} *{{ .RefType.Name }}) Find{{ .RefFuncName }}(ctx context.Context, db YODB) ([]*{{ .Type.Name }}, error) {
+	const sqlstr = "SELECT " +
+		"{{ columnNamesWithoutHidden .Type.Fields }} " +
+		"FROM {{ escape .Type.TableName }} " +
+		"WHERE {{ columnNamesQuery .Fields " AND " }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .RefFields }}
+	stmt.Params["{{ paramName $i }}"] = yoEncode({{ $short }}.{{ $f.Name }})
+	{{- end }}
+
+	decoder := new{{ .Type.Name }}_Decoder({{ .Type.Name }}Columns())
+
+	// run query
+	YOLog(ctx, sqlstr, {{ fieldNames .RefFields $short }})
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ .Type.Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("{{ .RefType.Name }}.Find{{ .RefFuncName }}", "{{ .Type.TableName }}", err)
+		}
+
+		referrer, err := decoder(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ .RefType.Name }}.Find{{ .RefFuncName }}", "{{ .Type.TableName }}", err)
+		}
+
+		res = append(res, referrer)
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- range .GraphTraversals }}
+{{ if .Incoming }}
+// {{ .FuncName }} retrieves the {{ .To.Type.Name }} rows which point to the {{ .From.Type.Name }}
+{{- else }}
+// {{ .FuncName }} retrieves the {{ .To.Type.Name }} rows which the {{ .From.Type.Name }} points to
+{{- end }}
+// by the edges of the label '{{ .Label }}' in the property graph '{{ .Graph.GraphName }}'.
+{{- if .Graph.Comment }}
+//
+{{ docComment .Graph.Comment "" }}
+{{- end }}
+func ({{ $short }} *{{ .From.Type.Name }}) {{ .FuncName }}(ctx context.Context, db YODB) ([]*{{ .To.Type.Name }}, error) {
+	const sqlstr = "{{ graphQuery . }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .From.KeyFields }}
+	stmt.Params["{{ paramName $i }}"] = yoEncode({{ $short }}.{{ $f.Name }})
+	{{- end }}
+
+	decoder := new{{ .To.Type.Name }}_Decoder({{ .To.Type.Name }}Columns())
+
+	// run query
+	YOLog(ctx, sqlstr, {{ fieldNames .From.KeyFields $short }})
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ .To.Type.Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("{{ .From.Type.Name }}.{{ .FuncName }}", "{{ .To.Type.TableName }}", err)
+		}
+
+		node, err := decoder(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ .From.Type.Name }}.{{ .FuncName }}", "{{ .To.Type.TableName }}", err)
+		}
+
+		res = append(res, node)
+	}
+
+	return res, nil
+}
+{{- end }}
 
 // Delete deletes the {{ .Name }} from the database.
+{{- range .Children }}
+{{- if eq .OnDeleteAction "CASCADE" }}
+// The interleaved {{ .Name }} rows are deleted together (ON DELETE CASCADE).
+{{- else }}
+// It fails if {{ .Name }} rows are interleaved in it (ON DELETE NO ACTION).
+{{- end }}
+{{- end }}
 func ({{ $short }} *{{ .Name }}) Delete(ctx context.Context) *spanner.Mutation {
 	values, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())
 	return spanner.Delete("{{ $table }}", spanner.Key(values))
 }
+{{- end }}
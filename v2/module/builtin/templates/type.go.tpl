@@ -1,9 +1,20 @@
 {{- $short := (shortName .Name "err" "res" "sqlstr" "db" "YOLog") -}}
 {{- $table := (.TableName) -}}
 
+{{- if .IsView -}}
+// {{ .Name }} represents a row from the view '{{ $table }}'. It is read-only.
+{{- else -}}
 // {{ .Name }} represents a row from '{{ $table }}'.
+{{- end }}
+{{- if .Comment }}
+//
+{{ docComment .Comment "" }}
+{{- end }}
 type {{ .Name }} struct {
 {{- range .Fields }}
+{{- if and .Comment (not .IsHidden) }}
+{{ docComment .Comment "\t" }}
+{{- end }}
 {{- if .IsHidden }}
 {{- else if eq (.SpannerDataType) (.ColumnName) }}
 	{{ .Name }} string `spanner:"{{ .ColumnName }}" json:"{{ .ColumnName }}"` // {{ .ColumnName }} enum
@@ -12,6 +23,7 @@ type {{ .Name }} struct {
 {{- end }}
 {{- end }}
 }
+{{- if not .IsView }}
 
 func {{ .Name }}PrimaryKeys() []string {
      return []string{
@@ -20,6 +32,7 @@ func {{ .Name }}PrimaryKeys() []string {
 {{- end }}
 	}
 }
+{{- end }}
 
 func {{ .Name }}Columns() []string {
 	return []string{
@@ -31,6 +44,8 @@ func {{ .Name }}Columns() []string {
 	}
 }
 
+{{- if not .IsView }}
+
 func {{ .Name }}WritableColumns() []string {
 	return []string{
 {{- range .Fields }}
@@ -40,6 +55,46 @@ func {{ .Name }}WritableColumns() []string {
 {{- end }}
 	}
 }
+{{- end }}
+
+{{- with .RowDeletionPolicy }}
+
+// {{ $.Name }}Retention is the retention of a row in '{{ $table }}' by the row deletion policy
+// on {{ .Field.ColumnName }}.
+const {{ $.Name }}Retention = {{ .NumDays }} * 24 * time.Hour
+
+// ExpiresAt returns the time when the row is expired by the row deletion policy of '{{ $table }}'.
+// The expired row is deleted in the background later.
+{{- if not .Field.IsNotNull }}
+// It returns the zero time if {{ .Field.Name }} is NULL because the row is never deleted.
+{{- end }}
+func ({{ $short }} *{{ $.Name }}) ExpiresAt() time.Time {
+{{- if .Field.IsNotNull }}
+	return {{ $short }}.{{ .Field.Name }}.Add({{ $.Name }}Retention)
+{{- else if eq .Field.Type "*time.Time" }}
+	if {{ $short }}.{{ .Field.Name }} == nil {
+		return time.Time{}
+	}
+	return {{ $short }}.{{ .Field.Name }}.Add({{ $.Name }}Retention)
+{{- else }}
+	if !{{ $short }}.{{ .Field.Name }}.Valid {
+		return time.Time{}
+	}
+	return {{ $short }}.{{ .Field.Name }}.Time.Add({{ $.Name }}Retention)
+{{- end }}
+}
+
+// IsExpired reports whether the row is expired at now by the row deletion policy of '{{ $table }}'.
+func ({{ $short }} *{{ $.Name }}) IsExpired(now time.Time) bool {
+{{- if .Field.IsNotNull }}
+	return now.After({{ $short }}.{{ .Field.Name }}.Add({{ $.Name }}Retention))
+{{- else if eq .Field.Type "*time.Time" }}
+	return {{ $short }}.{{ .Field.Name }} != nil && now.After({{ $short }}.{{ .Field.Name }}.Add({{ $.Name }}Retention))
+{{- else }}
+	return {{ $short }}.{{ .Field.Name }}.Valid && now.After({{ $short }}.{{ .Field.Name }}.Time.Add({{ $.Name }}Retention))
+{{- end }}
+}
+{{- end }}
 
 func ({{ $short }} *{{ .Name }}) columnsToPtrs(cols []string) ([]interface{}, error) {
 	ret := make([]interface{}, 0, len(cols))
@@ -48,7 +103,15 @@ func ({{ $short }} *{{ .Name }}) columnsToPtrs(cols []string) ([]interface{}, er
 {{- range .Fields }}
 	{{- if not .IsHidden }}
 		case "{{ .ColumnName }}":
+		{{- if and .ProtoType (eq .Type (printf "*%s" .ProtoType.GoType)) }}
+			{{- if eq .ProtoType.Kind "PROTO" }}
+			ret = append(ret, yoDecodeProtoMessage(&{{ $short }}.{{ .Name }}))
+			{{- else }}
+			ret = append(ret, yoDecodeProtoEnum(&{{ $short }}.{{ .Name }}))
+			{{- end }}
+		{{- else }}
 			ret = append(ret, yoDecode(&{{ $short }}.{{ .Name }}))
+		{{- end }}
 	{{- end }}
 {{- end }}
 		default:
@@ -58,6 +121,10 @@ func ({{ $short }} *{{ .Name }}) columnsToPtrs(cols []string) ([]interface{}, er
 	return ret, nil
 }
 
+{{- if not .IsView }}
+
+{{- $vectorFields := vectorFields .Fields }}
+
 func ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{}, error) {
 	ret := make([]interface{}, 0, len(cols))
 	for _, col := range cols {
@@ -65,6 +132,11 @@ func ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{},
 {{- range .Fields }}
 	{{- if not .IsHidden }}
 		case "{{ .ColumnName }}":
+		{{- if hasField $vectorFields .Name }}
+			if {{ if not .IsNotNull }}{{ $short }}.{{ .Name }} != nil && {{ end }}len({{ $short }}.{{ .Name }}) != {{ .VectorLength }} {
+				return nil, fmt.Errorf("vector length of {{ .ColumnName }} must be {{ .VectorLength }}, but got %d", len({{ $short }}.{{ .Name }}))
+			}
+		{{- end }}
 			ret = append(ret, yoEncode({{ $short }}.{{ .Name }}))
 	{{- end }}
 {{- end }}
@@ -75,6 +147,18 @@ func ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{},
 
 	return ret, nil
 }
+{{- if $vectorFields }}
+
+// Validate checks the lengths of the vectors of {{ .Name }} by vector_length.
+// The mutations of {{ .Name }} with an invalid vector fail to be applied.
+func ({{ $short }} *{{ .Name }}) Validate() error {
+	if _, err := {{ $short }}.columnsToValues({{ .Name }}WritableColumns()); err != nil {
+		return newErrorWithCode(codes.InvalidArgument, "{{ .Name }}.Validate", "{{ .TableName }}", err)
+	}
+	return nil
+}
+{{- end }}
+{{- end }}
 
 // new{{ .Name }}_Decoder returns a decoder which reads a row from *spanner.Row
 // into {{ .Name }}. The decoder is not goroutine-safe. Don't use it concurrently.
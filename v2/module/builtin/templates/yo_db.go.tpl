@@ -8,6 +8,28 @@ type YODB interface {
 
 // YOLog provides the log func used by generated queries.
 var YOLog = func(context.Context, string, ...interface{}) { }
+{{- if .Schema.SearchIndexes }}
+
+// YOSearchOptions is the options of the full-text search funcs generated from
+// search indexes. A nil *YOSearchOptions is the zero options.
+type YOSearchOptions struct {
+	// EnhanceQuery enables enhance_query of SEARCH, SCORE and SNIPPET to
+	// search for synonyms and spelling corrections of the query too.
+	EnhanceQuery bool
+	// OrderByScore orders the rows by SCORE in descending order.
+	OrderByScore bool
+	// Limit limits the number of the rows. No limit if 0.
+	Limit int64
+	// Snippet sets Snippet of the results by SNIPPET.
+	Snippet bool
+}
+{{- end }}
+{{- if .Schema.VectorIndexes }}
+
+// YONumLeavesToSearch is num_leaves_to_search of the approximate nearest
+// neighbor searches generated from vector indexes.
+var YONumLeavesToSearch = 10
+{{- end }}
 
 func newError(method, table string, err error) error {
 	code := spanner.ErrCode(err)
@@ -77,10 +99,71 @@ func yoEncode(v interface{}) interface{} {
 		return int64(vv)
 	case uint64:
 		return int64(vv)
+{{- if .Schema.UsesIntArrays }}
+	case []int8:
+		return yoEncodeIntArray(vv)
+	case []int16:
+		return yoEncodeIntArray(vv)
+	case []uint16:
+		return yoEncodeIntArray(vv)
+	case []int32:
+		return yoEncodeIntArray(vv)
+	case []uint32:
+		return yoEncodeIntArray(vv)
+	case []uint64:
+		return yoEncodeIntArray(vv)
+	case []*int8:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint8:
+		return yoEncodeIntPtrArray(vv)
+	case []*int16:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint16:
+		return yoEncodeIntPtrArray(vv)
+	case []*int32:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint32:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint64:
+		return yoEncodeIntPtrArray(vv)
+{{- end }}
 	default:
 		return v
 	}
 }
+{{- if .Schema.UsesIntArrays }}
+
+// yoInt is a primitive integer type that spanner library does not support. []uint8 is not
+// included because it is []byte for BYTES.
+type yoInt interface {
+	int8 | uint8 | int16 | uint16 | int32 | uint32 | uint64
+}
+
+func yoEncodeIntArray[T yoInt](vv []T) []int64 {
+	if vv == nil {
+		return nil
+	}
+	ret := make([]int64, len(vv))
+	for i, v := range vv {
+		ret[i] = int64(v)
+	}
+	return ret
+}
+
+func yoEncodeIntPtrArray[T yoInt](vv []*T) []*int64 {
+	if vv == nil {
+		return nil
+	}
+	ret := make([]*int64, len(vv))
+	for i, v := range vv {
+		if v != nil {
+			n := int64(*v)
+			ret[i] = &n
+		}
+	}
+	return ret
+}
+{{- end }}
 
 // yoDecode wraps primitive types that spanner library does not support to decode from spanner types
 // by yoPrimitiveDecoder before passing to spanner functions. Supported primitive types and
@@ -90,6 +173,11 @@ func yoDecode(ptr interface{}) interface{} {
 	switch ptr.(type) {
 	case *int8, *uint8, *int16, *uint16, *int32, *uint32, *uint64:
 		return &yoPrimitiveDecoder{val: ptr}
+{{- if .Schema.UsesIntArrays }}
+	case *[]int8, *[]int16, *[]uint16, *[]int32, *[]uint32, *[]uint64,
+		*[]*int8, *[]*uint8, *[]*int16, *[]*uint16, *[]*int32, *[]*uint32, *[]*uint64:
+		return &yoPrimitiveArrayDecoder{val: ptr}
+{{- end }}
 	default:
 		return ptr
 	}
@@ -131,3 +219,175 @@ func (y *yoPrimitiveDecoder) DecodeSpanner(val interface{}) error {
 
 	return nil
 }
+
+{{- if .Schema.UsesIntArrays }}
+
+// yoPrimitiveArrayDecoder decodes ARRAY<INT64> into a slice of primitive types. The elements
+// are pointers if the array may contain NULL.
+type yoPrimitiveArrayDecoder struct {
+	val interface{}
+}
+
+func (y *yoPrimitiveArrayDecoder) DecodeSpanner(val interface{}) error {
+	var list *structpb.ListValue
+	switch vv := val.(type) {
+	case *structpb.ListValue:
+		list = vv
+	case *string:
+		// NULL
+	default:
+		return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "failed to decode customField: %T(%v)", val, val))
+	}
+
+	switch vv := y.val.(type) {
+	case *[]int8:
+		return yoDecodeIntArray(vv, list)
+	case *[]int16:
+		return yoDecodeIntArray(vv, list)
+	case *[]uint16:
+		return yoDecodeIntArray(vv, list)
+	case *[]int32:
+		return yoDecodeIntArray(vv, list)
+	case *[]uint32:
+		return yoDecodeIntArray(vv, list)
+	case *[]uint64:
+		return yoDecodeIntArray(vv, list)
+	case *[]*int8:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint8:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*int16:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint16:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*int32:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint32:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint64:
+		return yoDecodeIntPtrArray(vv, list)
+	default:
+		return status.Errorf(codes.Internal, "unexpected type for yoPrimitiveArrayDecoder: %T", y.val)
+	}
+}
+
+func yoDecodeIntArray[T yoInt](ptr *[]T, list *structpb.ListValue) error {
+	vals, err := yoDecodeIntPtrs[T](list)
+	if err != nil {
+		return err
+	}
+	if vals == nil {
+		*ptr = nil
+		return nil
+	}
+
+	ret := make([]T, len(vals))
+	for i, v := range vals {
+		if v == nil {
+			return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "cannot decode NULL element into %T", *ptr))
+		}
+		ret[i] = *v
+	}
+	*ptr = ret
+	return nil
+}
+
+func yoDecodeIntPtrArray[T yoInt](ptr *[]*T, list *structpb.ListValue) error {
+	vals, err := yoDecodeIntPtrs[T](list)
+	if err != nil {
+		return err
+	}
+	*ptr = vals
+	return nil
+}
+
+func yoDecodeIntPtrs[T yoInt](list *structpb.ListValue) ([]*T, error) {
+	if list == nil {
+		return nil, nil
+	}
+
+	ret := make([]*T, len(list.Values))
+	for i, v := range list.Values {
+		if _, ok := v.Kind.(*structpb.Value_NullValue); ok {
+			continue
+		}
+
+		intVal, err := strconv.ParseInt(v.GetStringValue(), 10, 64)
+		if err != nil {
+			return nil, spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "%v wasn't correctly encoded: <%v>", v, err))
+		}
+		n := T(intVal)
+		ret[i] = &n
+	}
+	return ret, nil
+}
+{{- end }}
+{{- if .Schema.ProtoTypes }}
+
+// yoDecodeProtoMessage wraps a pointer to a proto message field by yoProtoMessageDecoder
+// to decode NULL of a PROTO column into nil.
+func yoDecodeProtoMessage[T any, M interface {
+	*T
+	proto.Message
+}](ptr *M) interface{} {
+	return &yoProtoMessageDecoder[T, M]{ptr: ptr}
+}
+
+type yoProtoMessageDecoder[T any, M interface {
+	*T
+	proto.Message
+}] struct {
+	ptr *M
+}
+
+func (y *yoProtoMessageDecoder[T, M]) DecodeSpanner(val interface{}) error {
+	switch vv := val.(type) {
+	case *string:
+		*y.ptr = nil
+		return nil
+	case string:
+		b, err := base64.StdEncoding.DecodeString(vv)
+		if err != nil {
+			return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "%v wasn't correctly encoded: <%v>", val, err))
+		}
+
+		m := M(new(T))
+		if err := proto.Unmarshal(b, m); err != nil {
+			return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "failed to unmarshal %T: <%v>", m, err))
+		}
+		*y.ptr = m
+		return nil
+	default:
+		return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "failed to decode proto message: %T(%v)", val, val))
+	}
+}
+
+// yoDecodeProtoEnum wraps a pointer to a nullable proto enum field by yoProtoEnumDecoder
+// to decode NULL of an ENUM column into nil.
+func yoDecodeProtoEnum[T ~int32](ptr **T) interface{} {
+	return &yoProtoEnumDecoder[T]{ptr: ptr}
+}
+
+type yoProtoEnumDecoder[T ~int32] struct {
+	ptr **T
+}
+
+func (y *yoProtoEnumDecoder[T]) DecodeSpanner(val interface{}) error {
+	switch vv := val.(type) {
+	case *string:
+		*y.ptr = nil
+		return nil
+	case string:
+		intVal, err := strconv.ParseInt(vv, 10, 32)
+		if err != nil {
+			return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "%v wasn't correctly encoded: <%v>", val, err))
+		}
+
+		e := T(intVal)
+		*y.ptr = &e
+		return nil
+	default:
+		return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "failed to decode proto enum: %T(%v)", val, val))
+	}
+}
+{{- end }}
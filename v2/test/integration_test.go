@@ -25,6 +25,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
@@ -1284,3 +1285,493 @@ func TestInflectionzz(t *testing.T) {
 		}
 	})
 }
+
+func TestInterleave(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	parent := &default_models.ParentItem{ParentID: 1, Name: "parent"}
+	other := &default_models.ParentItem{ParentID: 2, Name: "other"}
+	children := []*default_models.ChildItem{
+		{ParentID: 1, ChildID: 1, Name: "child1"},
+		{ParentID: 1, ChildID: 2, Name: "child2"},
+	}
+	otherChild := &default_models.ChildItem{ParentID: 2, ChildID: 1, Name: "other child"}
+
+	muts := []*spanner.Mutation{parent.Insert(ctx), other.Insert(ctx), otherChild.Insert(ctx)}
+	for _, c := range children {
+		muts = append(muts, c.Insert(ctx))
+	}
+	if _, err := client.Apply(ctx, muts); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	t.Run("ReadChildren", func(t *testing.T) {
+		got, err := parent.ReadChildItems(ctx, client.Single())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if diff := cmp.Diff(children, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+
+	t.Run("FindByInterleavedIndex", func(t *testing.T) {
+		got, err := parent.FindChildItemsByChildItemsByName(ctx, client.Single(), "child1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if diff := cmp.Diff(children[:1], got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+
+	t.Run("DeleteCascade", func(t *testing.T) {
+		if _, err := client.Apply(ctx, []*spanner.Mutation{parent.Delete(ctx)}); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+
+		got, err := default_models.ReadChildItem(ctx, client.Single(), spanner.AllKeys())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if diff := cmp.Diff([]*default_models.ChildItem{otherChild}, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+}
+
+func TestForeignKey(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	item := &default_models.Item{ID: 1, Price: 100}
+	fereignItems := []*default_models.FereignItem{
+		{ID: 1, ItemID: 1, Category: 10},
+		{ID: 2, ItemID: 1, Category: 20},
+	}
+
+	muts := []*spanner.Mutation{item.Insert(ctx)}
+	for _, fi := range fereignItems {
+		muts = append(muts, fi.Insert(ctx))
+	}
+	if _, err := client.Apply(ctx, muts); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	t.Run("FindReferenced", func(t *testing.T) {
+		got, err := fereignItems[0].FindItem(ctx, client.Single())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if diff := cmp.Diff(item, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+
+	t.Run("FindReferencing", func(t *testing.T) {
+		got, err := item.FindFereignItems(ctx, client.Single())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if diff := cmp.Diff(fereignItems, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+}
+
+func TestCommitTimestamp(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	ct := &default_models.CommitTimestamp{ID: "x", Value: "v1"}
+
+	commitTs, err := client.Apply(ctx, []*spanner.Mutation{ct.Insert(ctx)})
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	got, err := default_models.FindCommitTimestamp(ctx, client.Single(), "x")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.UpdatedAt.Equal(commitTs) {
+		t.Errorf("expected UpdatedAt %v, but got %v", commitTs, got.UpdatedAt)
+	}
+	if got.DeletedAt.Valid {
+		t.Errorf("expected DeletedAt to be NULL, but got %v", got.DeletedAt)
+	}
+
+	ct.Value = "v2"
+	commitTs, err = client.Apply(ctx, []*spanner.Mutation{ct.Update(ctx)})
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	got, err = default_models.FindCommitTimestamp(ctx, client.Single(), "x")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.UpdatedAt.Equal(commitTs) {
+		t.Errorf("expected UpdatedAt %v, but got %v", commitTs, got.UpdatedAt)
+	}
+}
+
+func TestRowDeletionPolicy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	now := time.Now()
+	active := &default_models.Session{ID: "active", UserID: 1, LastAccessedAt: spanner.NullTime{Time: now, Valid: true}}
+	expired := &default_models.Session{ID: "expired", UserID: 1, LastAccessedAt: spanner.NullTime{Time: now.Add(-default_models.SessionRetention - time.Hour), Valid: true}}
+	permanent := &default_models.Session{ID: "permanent", UserID: 1}
+
+	if _, err := client.Apply(ctx, []*spanner.Mutation{active.Insert(ctx), expired.Insert(ctx), permanent.Insert(ctx)}); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	t.Run("IsExpired", func(t *testing.T) {
+		if active.IsExpired(now) || !expired.IsExpired(now) || permanent.IsExpired(now) {
+			t.Errorf("expected only the expired session to be expired")
+		}
+		if !permanent.ExpiresAt().IsZero() {
+			t.Errorf("expected ExpiresAt of the permanent session to be zero, but got %v", permanent.ExpiresAt())
+		}
+	})
+
+	t.Run("FindByPrimaryKey", func(t *testing.T) {
+		if _, err := default_models.FindSession(ctx, client.Single(), "active"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err := default_models.FindSession(ctx, client.Single(), "expired")
+		testGRPCStatus(t, err, codes.NotFound)
+	})
+
+	t.Run("FindByIndex", func(t *testing.T) {
+		got, err := default_models.FindSessionsBySessionsByUserID(ctx, client.Single(), 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var ids []string
+		for _, s := range got {
+			ids = append(ids, s.ID)
+		}
+		if diff := cmp.Diff([]string{"active", "permanent"}, ids); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+
+	t.Run("ReadByKeySet", func(t *testing.T) {
+		got, err := default_models.ReadSession(ctx, client.Single(), spanner.AllKeys())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var ids []string
+		for _, s := range got {
+			ids = append(ids, s.ID)
+		}
+		if diff := cmp.Diff([]string{"active", "permanent"}, ids); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+}
+
+func TestInsertReturning(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	order := &default_models.Order{Item: "book"}
+	commitTs, err := client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		return order.InsertReturning(ctx, txn, "Quantity")
+	})
+	if err != nil {
+		t.Fatalf("ReadWriteTransaction failed: %v", err)
+	}
+
+	if order.OrderID <= 0 {
+		t.Fatalf("expected OrderID to be assigned by the sequence, but got %v", order.OrderID)
+	}
+
+	got, err := default_models.FindOrder(ctx, client.Single(), order.OrderID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Item != "book" {
+		t.Errorf("expected Item %v, but got %v", "book", got.Item)
+	}
+	if got.Quantity != 1 {
+		t.Errorf("expected Quantity to be the default value %v, but got %v", 1, got.Quantity)
+	}
+	if !got.CreatedAt.Equal(commitTs) {
+		t.Errorf("expected CreatedAt %v, but got %v", commitTs, got.CreatedAt)
+	}
+
+	t.Run("Quantity", func(t *testing.T) {
+		order := &default_models.Order{Item: "pen", Quantity: 3}
+		if _, err := client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+			return order.InsertReturning(ctx, txn)
+		}); err != nil {
+			t.Fatalf("ReadWriteTransaction failed: %v", err)
+		}
+
+		got, err := default_models.FindOrder(ctx, client.Single(), order.OrderID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Quantity != 3 {
+			t.Errorf("expected Quantity %v, but got %v", 3, got.Quantity)
+		}
+	})
+
+	t.Run("ZeroQuantity", func(t *testing.T) {
+		order := &default_models.Order{Item: "eraser"}
+		if _, err := client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+			return order.InsertReturning(ctx, txn)
+		}); err != nil {
+			t.Fatalf("ReadWriteTransaction failed: %v", err)
+		}
+
+		got, err := default_models.FindOrder(ctx, client.Single(), order.OrderID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Quantity != 0 {
+			t.Errorf("expected Quantity %v, but got %v", 0, got.Quantity)
+		}
+	})
+}
+
+func TestArrayElements(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	i32 := int32(3)
+	i64 := int64(5)
+	date := civil.Date{Year: 2024, Month: 1, Day: 2}
+	withNulls := &default_models.ArrayElement{
+		ID:                  1,
+		Strings:             []string{"a"},
+		NullableStrings:     []spanner.NullString{{StringVal: "a", Valid: true}, {}},
+		PointerInt64s:       []*int64{&i64, nil},
+		PointerDates:        []*civil.Date{nil, &date},
+		CustomInt32s:        []int32{1, 2},
+		CustomPointerInt32s: []*int32{nil, &i32},
+	}
+	nulls := &default_models.ArrayElement{ID: 2}
+
+	if _, err := client.Apply(ctx, []*spanner.Mutation{withNulls.Insert(ctx), nulls.Insert(ctx)}); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	for _, expected := range []*default_models.ArrayElement{withNulls, nulls} {
+		got, err := default_models.FindArrayElement(ctx, client.Single(), expected.ID)
+		if err != nil {
+			t.Fatalf("FindArrayElement failed: %v", err)
+		}
+		if diff := cmp.Diff(expected, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	}
+}
+
+func TestPointerFields(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	name := "alice"
+	count := int64(3)
+	rate := 0.5
+	enabled := true
+	birthDate := civil.Date{Year: 2000, Month: 1, Day: 2}
+	updatedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	values := &default_models.PointerField{
+		ID:        1,
+		Name:      &name,
+		Count:     &count,
+		Rate:      &rate,
+		Enabled:   &enabled,
+		BirthDate: &birthDate,
+		UpdatedAt: &updatedAt,
+		Data:      []byte("data"),
+	}
+	nulls := &default_models.PointerField{ID: 2}
+
+	if _, err := client.Apply(ctx, []*spanner.Mutation{values.Insert(ctx), nulls.Insert(ctx)}); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	for _, expected := range []*default_models.PointerField{values, nulls} {
+		got, err := default_models.FindPointerField(ctx, client.Single(), expected.ID)
+		if err != nil {
+			t.Fatalf("FindPointerField failed: %v", err)
+		}
+		if diff := cmp.Diff(expected, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	}
+
+	t.Run("FindByIndex", func(t *testing.T) {
+		for _, expected := range []*default_models.PointerField{values, nulls} {
+			got, err := default_models.FindPointerFieldsByPointerFieldsByName(ctx, client.Single(), expected.Name)
+			if err != nil {
+				t.Fatalf("FindPointerFieldsByPointerFieldsByName failed: %v", err)
+			}
+			if diff := cmp.Diff([]*default_models.PointerField{expected}, got); diff != "" {
+				t.Errorf("(-got, +want)\n%s", diff)
+			}
+		}
+	})
+
+	t.Run("UpdateToNull", func(t *testing.T) {
+		updated := *values
+		updated.Name = nil
+		updated.UpdatedAt = nil
+
+		if _, err := client.Apply(ctx, []*spanner.Mutation{updated.Update(ctx)}); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+
+		got, err := default_models.FindPointerField(ctx, client.Single(), updated.ID)
+		if err != nil {
+			t.Fatalf("FindPointerField failed: %v", err)
+		}
+		if diff := cmp.Diff(&updated, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+}
+
+func TestInsertWithDefaults(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	defaults := &default_models.DefaultValue{ID: 1}
+	values := &default_models.DefaultValue{ID: 2, Name: "name", Count: 10}
+	zeros := &default_models.DefaultValue{ID: 3}
+
+	if _, err := client.Apply(ctx, []*spanner.Mutation{
+		defaults.InsertWithDefaults(ctx, "Name", "Count", "CreatedAt"),
+		values.InsertWithDefaults(ctx, "CreatedAt"),
+		// a zero value is written as is unless the column is omitted
+		zeros.InsertWithDefaults(ctx, "Name", "CreatedAt"),
+	}); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	got, err := default_models.ReadDefaultValue(ctx, client.Single(), spanner.AllKeys())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expect the number of rows %v, but got %v", 3, len(got))
+	}
+
+	for _, dv := range got {
+		if dv.CreatedAt.IsZero() {
+			t.Errorf("expected CreatedAt to be set by the default value, but got zero")
+		}
+		dv.CreatedAt = time.Time{}
+	}
+
+	expected := []*default_models.DefaultValue{
+		{ID: 1, Name: "unknown", Count: 1},
+		{ID: 2, Name: "name", Count: 10},
+		{ID: 3, Name: "unknown", Count: 0},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+}
+
+func TestView(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := testutil.DeleteAllData(ctx, client); err != nil {
+		t.Fatalf("failed to clear data: %v", err)
+	}
+
+	items := []*default_models.Item{
+		{ID: 1, Price: 100},
+		{ID: 2, Price: 2000},
+		{ID: 3, Price: 3000},
+	}
+
+	var muts []*spanner.Mutation
+	for _, item := range items {
+		muts = append(muts, item.Insert(ctx))
+	}
+	if _, err := client.Apply(ctx, muts); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	t.Run("FindAll", func(t *testing.T) {
+		got, err := default_models.FindExpensiveItems(ctx, client.Single(), "", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []*default_models.ExpensiveItem{
+			{ID: spanner.NullInt64{Int64: 2, Valid: true}, Price: spanner.NullInt64{Int64: 2000, Valid: true}, DoublePrice: spanner.NullInt64{Int64: 4000, Valid: true}},
+			{ID: spanner.NullInt64{Int64: 3, Valid: true}, Price: spanner.NullInt64{Int64: 3000, Valid: true}, DoublePrice: spanner.NullInt64{Int64: 6000, Valid: true}},
+		}
+		sort.Slice(got, func(i, j int) bool { return got[i].ID.Int64 < got[j].ID.Int64 })
+		if diff := cmp.Diff(expected, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+
+	t.Run("FindWhere", func(t *testing.T) {
+		got, err := default_models.FindExpensiveItems(ctx, client.Single(), "ID = @id", map[string]interface{}{"id": int64(3)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []*default_models.ExpensiveItem{
+			{ID: spanner.NullInt64{Int64: 3, Valid: true}, Price: spanner.NullInt64{Int64: 3000, Valid: true}, DoublePrice: spanner.NullInt64{Int64: 6000, Valid: true}},
+		}
+		if diff := cmp.Diff(expected, got); diff != "" {
+			t.Errorf("(-got, +want)\n%s", diff)
+		}
+	})
+}
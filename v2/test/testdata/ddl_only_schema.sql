@@ -0,0 +1,34 @@
+-- Copyright (c) 2020 Mercari, Inc.
+--
+-- Permission is hereby granted, free of charge, to any person obtaining a copy of
+-- this software and associated documentation files (the "Software"), to deal in
+-- the Software without restriction, including without limitation the rights to
+-- use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+-- the Software, and to permit persons to whom the Software is furnished to do so,
+-- subject to the following conditions:
+--
+-- The above copyright notice and this permission notice shall be included in all
+-- copies or substantial portions of the Software.
+--
+-- THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+-- IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+-- FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+-- COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+-- IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+-- CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- The tables in this file are generated by --from-ddl only, because they use
+-- the types which the spanner emulator for the integration tests doesn't
+-- support.
+
+CREATE TABLE NullTypes (
+  ID INT64 NOT NULL,
+  Float32 FLOAT32 NOT NULL,
+  NullFloat32 FLOAT32,
+  UUID UUID NOT NULL,
+  NullUUID UUID,
+) PRIMARY KEY(ID);
+
+CREATE INDEX NullTypesByNullFloat32 ON NullTypes(NullFloat32);
+CREATE INDEX NullTypesByNullUUID ON NullTypes(NullUUID);
+CREATE UNIQUE INDEX NullTypesByFloat32UUID ON NullTypes(Float32, UUID);
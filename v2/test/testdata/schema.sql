@@ -99,6 +99,8 @@ CREATE INDEX FullTypesByInTimestampNull ON FullTypes(FTInt, FTTimestampNull);
 
 CREATE INDEX FullTypesByTimestamp ON FullTypes(FTTimestamp);
 
+CREATE NULL_FILTERED INDEX FullTypesByIntStringNull ON FullTypes(FTInt, FTStringNull);
+
 CREATE TABLE CustomPrimitiveTypes (
   PKey STRING(32) NOT NULL,
   FTInt64 INT64 NOT NULL,
@@ -171,3 +173,88 @@ CREATE TABLE Inflectionzz (
   X STRING(32) NOT NULL,
   Y STRING(32) NOT NULL,
 ) PRIMARY KEY(X);
+
+CREATE TABLE ParentItems (
+  ParentID INT64 NOT NULL,
+  Name STRING(32) NOT NULL,
+) PRIMARY KEY(ParentID);
+
+CREATE TABLE ChildItems (
+  ParentID INT64 NOT NULL,
+  ChildID INT64 NOT NULL,
+  Name STRING(32) NOT NULL,
+) PRIMARY KEY(ParentID, ChildID),
+INTERLEAVE IN PARENT ParentItems ON DELETE CASCADE;
+
+CREATE INDEX ChildItemsByName ON ChildItems(ParentID, Name DESC), INTERLEAVE IN ParentItems;
+
+CREATE TABLE GrandchildItems (
+  ParentID INT64 NOT NULL,
+  ChildID INT64 NOT NULL,
+  GrandchildID INT64 NOT NULL,
+) PRIMARY KEY(ParentID, ChildID, GrandchildID),
+INTERLEAVE IN PARENT ChildItems;
+
+CREATE TABLE CommitTimestamps (
+  ID STRING(32) NOT NULL,
+  Value STRING(32) NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+  DeletedAt TIMESTAMP OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY(ID);
+
+CREATE TABLE DefaultValues (
+  ID INT64 NOT NULL,
+  Name STRING(32) NOT NULL DEFAULT ("unknown"),
+  Count INT64 NOT NULL DEFAULT (1),
+  Note STRING(MAX),
+  CreatedAt TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP()),
+) PRIMARY KEY(ID);
+
+-- Sessions are login sessions of users.
+-- A session is deleted a week after the last access.
+CREATE TABLE Sessions (
+  ID STRING(32) NOT NULL,
+  UserID INT64 NOT NULL, -- user who logged in
+  -- time when the session is used last.
+  -- NULL for a session which is never used.
+  LastAccessedAt TIMESTAMP,
+) PRIMARY KEY(ID),
+ROW DELETION POLICY (OLDER_THAN(LastAccessedAt, INTERVAL 7 DAY));
+
+/* lists the sessions of a user */
+CREATE INDEX SessionsByUserID ON Sessions(UserID);
+
+CREATE SEQUENCE OrderSequence OPTIONS (sequence_kind = "bit_reversed_positive");
+
+CREATE TABLE Orders (
+  OrderID INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE OrderSequence)),
+  Item STRING(32) NOT NULL,
+  Quantity INT64 NOT NULL DEFAULT (1),
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY(OrderID);
+
+CREATE VIEW ExpensiveItems SQL SECURITY INVOKER AS
+SELECT i.ID, i.Price, CAST(i.Price * 2 AS INT64) AS DoublePrice FROM Items AS i WHERE i.Price > 1000;
+
+CREATE TABLE ArrayElements (
+  ID INT64 NOT NULL,
+  Strings ARRAY<STRING(MAX)>,
+  NullableStrings ARRAY<STRING(MAX)>,
+  PointerInt64s ARRAY<INT64>,
+  PointerDates ARRAY<DATE>,
+  CustomInt32s ARRAY<INT64>,
+  CustomPointerInt32s ARRAY<INT64>,
+) PRIMARY KEY(ID);
+
+CREATE TABLE PointerFields (
+  ID INT64 NOT NULL,
+  Name STRING(MAX),
+  Count INT64,
+  Rate FLOAT64,
+  Enabled BOOL,
+  BirthDate DATE,
+  UpdatedAt TIMESTAMP,
+  Data BYTES(MAX),
+) PRIMARY KEY(ID);
+
+CREATE INDEX PointerFieldsByName ON PointerFields(Name);
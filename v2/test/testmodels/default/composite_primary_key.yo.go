@@ -219,7 +219,7 @@ func FindCompositePrimaryKeysByCompositePrimaryKeysByError(ctx context.Context,
 	const sqlstr = "SELECT " +
 		"Id, PKey1, PKey2, Error, X, Y, Z " +
 		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
-		"WHERE Error = @param0"
+		"WHERE Error = @param0 ORDER BY Error, PKey1, PKey2"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(e)
@@ -294,7 +294,7 @@ func FindCompositePrimaryKeysByCompositePrimaryKeysByError2(ctx context.Context,
 	const sqlstr = "SELECT " +
 		"Id, PKey1, PKey2, Error, X, Y, Z " +
 		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
-		"WHERE Error = @param0"
+		"WHERE Error = @param0 ORDER BY Error, PKey1, PKey2"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(e)
@@ -370,7 +370,7 @@ func FindCompositePrimaryKeysByCompositePrimaryKeysByError3(ctx context.Context,
 	const sqlstr = "SELECT " +
 		"Id, PKey1, PKey2, Error, X, Y, Z " +
 		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
-		"WHERE Error = @param0"
+		"WHERE Error = @param0 ORDER BY Error, PKey1, PKey2"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(e)
@@ -447,7 +447,7 @@ func FindCompositePrimaryKeysByCompositePrimaryKeysByXY(ctx context.Context, db
 	const sqlstr = "SELECT " +
 		"Id, PKey1, PKey2, Error, X, Y, Z " +
 		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
-		"WHERE X = @param0 AND Y = @param1"
+		"WHERE X = @param0 AND Y = @param1 ORDER BY X, Y, PKey1, PKey2"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(x)
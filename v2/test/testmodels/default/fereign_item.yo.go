@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 )
 
@@ -176,6 +177,43 @@ func ReadFereignItem(ctx context.Context, db YODB, keys spanner.KeySet) ([]*Fere
 	return res, nil
 }
 
+// FindItem retrieves the Item referenced by the FereignItem
+// through the foreign key 'FK_ItemID_ForeignItems'.
+//
+// If no row is present, then it returns an error where spanner.ErrCode(err)
+// is codes.NotFound.
+func (fi *FereignItem) FindItem(ctx context.Context, db YODB) (*Item, error) {
+	const sqlstr = "SELECT " +
+		"ID, Price " +
+		"FROM Items " +
+		"WHERE ID = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = yoEncode(fi.ItemID)
+
+	decoder := newItem_Decoder(ItemColumns())
+
+	// run query
+	YOLog(ctx, sqlstr, fi.ItemID)
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newErrorWithCode(codes.NotFound, "FereignItem.FindItem", "Items", err)
+		}
+		return nil, newError("FereignItem.FindItem", "Items", err)
+	}
+
+	ref, err := decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FereignItem.FindItem", "Items", err)
+	}
+
+	return ref, nil
+}
+
 // Delete deletes the FereignItem from the database.
 func (fi *FereignItem) Delete(ctx context.Context) *spanner.Mutation {
 	values, _ := fi.columnsToValues(FereignItemPrimaryKeys())
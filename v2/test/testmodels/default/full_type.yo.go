@@ -483,6 +483,7 @@ func FindFullTypesByFullTypesByInTimestampNull(ctx context.Context, db YODB, fTI
 		conds[1] = "FTTimestampNull = @param1"
 	}
 	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+	sqlstr += " ORDER BY FTInt, FTTimestampNull, PKey"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(fTInt)
@@ -558,7 +559,7 @@ func FindFullTypesByFullTypesByIntDate(ctx context.Context, db YODB, fTInt int64
 	const sqlstr = "SELECT " +
 		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
 		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
-		"WHERE FTInt = @param0 AND FTDate = @param1"
+		"WHERE FTInt = @param0 AND FTDate = @param1 ORDER BY FTInt, FTDate, PKey"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(fTInt)
@@ -627,6 +628,82 @@ func ReadFullTypesByFullTypesByIntDate(ctx context.Context, db YODB, keys spanne
 	return res, nil
 }
 
+// FindFullTypesByFullTypesByIntStringNull retrieves multiple rows from 'FullTypes' as a slice of FullType.
+//
+// Generated from index 'FullTypesByIntStringNull'.
+func FindFullTypesByFullTypesByIntStringNull(ctx context.Context, db YODB, fTInt int64, fTStringNull spanner.NullString) ([]*FullType, error) {
+	const sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntStringNull} " +
+		"WHERE FTInt = @param0 AND FTStringNull = @param1 ORDER BY FTInt, FTStringNull, PKey"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = yoEncode(fTInt)
+	stmt.Params["param1"] = yoEncode(fTStringNull)
+
+	decoder := newFullType_Decoder(FullTypeColumns())
+
+	// run query
+	YOLog(ctx, sqlstr, fTInt, fTStringNull)
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	// load results
+	res := []*FullType{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByFullTypesByIntStringNull", "FullTypes", err)
+		}
+
+		ft, err := decoder(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFullTypesByIntStringNull", "FullTypes", err)
+		}
+
+		res = append(res, ft)
+	}
+
+	return res, nil
+}
+
+// ReadFullTypesByFullTypesByIntStringNull retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'FullTypesByIntStringNull'.
+func ReadFullTypesByFullTypesByIntStringNull(ctx context.Context, db YODB, keys spanner.KeySet) ([]*FullType, error) {
+	var res []*FullType
+	columns := []string{
+		"PKey",
+		"FTInt",
+		"FTStringNull",
+	}
+
+	decoder := newFullType_Decoder(columns)
+
+	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByIntStringNull", keys, columns)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFullTypesByIntStringNull", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
 // FindFullTypesByFullTypesByIntTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
 //
 // Generated from index 'FullTypesByIntTimestamp'.
@@ -634,7 +711,7 @@ func FindFullTypesByFullTypesByIntTimestamp(ctx context.Context, db YODB, fTInt
 	const sqlstr = "SELECT " +
 		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
 		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
-		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
+		"WHERE FTInt = @param0 AND FTTimestamp = @param1 ORDER BY FTInt, FTTimestamp, PKey"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(fTInt)
@@ -710,7 +787,7 @@ func FindFullTypesByFullTypesByTimestamp(ctx context.Context, db YODB, fTTimesta
 	const sqlstr = "SELECT " +
 		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
 		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
-		"WHERE FTTimestamp = @param0"
+		"WHERE FTTimestamp = @param0 ORDER BY FTTimestamp, PKey"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(fTTimestamp)
@@ -0,0 +1,108 @@
+// Code generated by yo. DO NOT EDIT.
+
+// Package models contains the types.
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+)
+
+// GrandchildItem represents a row from 'GrandchildItems'.
+type GrandchildItem struct {
+	ParentID     int64 `spanner:"ParentID" json:"ParentID"`         // ParentID
+	ChildID      int64 `spanner:"ChildID" json:"ChildID"`           // ChildID
+	GrandchildID int64 `spanner:"GrandchildID" json:"GrandchildID"` // GrandchildID
+}
+
+func GrandchildItemPrimaryKeys() []string {
+	return []string{
+		"ParentID",
+		"ChildID",
+		"GrandchildID",
+	}
+}
+
+func GrandchildItemColumns() []string {
+	return []string{
+		"ParentID",
+		"ChildID",
+		"GrandchildID",
+	}
+}
+
+func GrandchildItemWritableColumns() []string {
+	return []string{
+		"ParentID",
+		"ChildID",
+		"GrandchildID",
+	}
+}
+
+func (gi *GrandchildItem) columnsToPtrs(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "ParentID":
+			ret = append(ret, yoDecode(&gi.ParentID))
+		case "ChildID":
+			ret = append(ret, yoDecode(&gi.ChildID))
+		case "GrandchildID":
+			ret = append(ret, yoDecode(&gi.GrandchildID))
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (gi *GrandchildItem) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "ParentID":
+			ret = append(ret, yoEncode(gi.ParentID))
+		case "ChildID":
+			ret = append(ret, yoEncode(gi.ChildID))
+		case "GrandchildID":
+			ret = append(ret, yoEncode(gi.GrandchildID))
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newGrandchildItem_Decoder returns a decoder which reads a row from *spanner.Row
+// into GrandchildItem. The decoder is not goroutine-safe. Don't use it concurrently.
+func newGrandchildItem_Decoder(cols []string) func(*spanner.Row) (*GrandchildItem, error) {
+	return func(row *spanner.Row) (*GrandchildItem, error) {
+		var gi GrandchildItem
+		ptrs, err := gi.columnsToPtrs(cols)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &gi, nil
+	}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (gi *GrandchildItem) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := gi.columnsToValues(GrandchildItemWritableColumns())
+	return spanner.Insert("GrandchildItems", GrandchildItemWritableColumns(), values)
+}
+
+// Delete deletes the GrandchildItem from the database.
+func (gi *GrandchildItem) Delete(ctx context.Context) *spanner.Mutation {
+	values, _ := gi.columnsToValues(GrandchildItemPrimaryKeys())
+	return spanner.Delete("GrandchildItems", spanner.Key(values))
+}
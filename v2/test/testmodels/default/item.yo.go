@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 )
 
@@ -169,6 +170,46 @@ func ReadItem(ctx context.Context, db YODB, keys spanner.KeySet) ([]*Item, error
 	return res, nil
 }
 
+// FindFereignItems retrieves the FereignItem rows referencing the Item
+// through the foreign key 'FK_ItemID_ForeignItems'.
+func (i *Item) FindFereignItems(ctx context.Context, db YODB) ([]*FereignItem, error) {
+	const sqlstr = "SELECT " +
+		"ID, ItemID, Category " +
+		"FROM FereignItems " +
+		"WHERE ItemID = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = yoEncode(i.ID)
+
+	decoder := newFereignItem_Decoder(FereignItemColumns())
+
+	// run query
+	YOLog(ctx, sqlstr, i.ID)
+	iter := db.Query(ctx, stmt)
+	defer iter.Stop()
+
+	// load results
+	res := []*FereignItem{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("Item.FindFereignItems", "FereignItems", err)
+		}
+
+		referrer, err := decoder(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "Item.FindFereignItems", "FereignItems", err)
+		}
+
+		res = append(res, referrer)
+	}
+
+	return res, nil
+}
+
 // Delete deletes the Item from the database.
 func (i *Item) Delete(ctx context.Context) *spanner.Mutation {
 	values, _ := i.columnsToValues(ItemPrimaryKeys())
@@ -190,7 +190,7 @@ func FindSnakeCasesBySnakeCasesByStringID(ctx context.Context, db YODB, stringID
 	const sqlstr = "SELECT " +
 		"id, string_id, foo_bar_baz " +
 		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
-		"WHERE string_id = @param0 AND foo_bar_baz = @param1"
+		"WHERE string_id = @param0 AND foo_bar_baz = @param1 ORDER BY string_id, foo_bar_baz, id"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = yoEncode(stringID)
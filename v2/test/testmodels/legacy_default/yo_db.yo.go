@@ -13,6 +13,7 @@ import (
 	"github.com/googleapis/gax-go/v2/apierror"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // YODB is the common interface for database operations.
@@ -94,11 +95,68 @@ func yoEncode(v interface{}) interface{} {
 		return int64(vv)
 	case uint64:
 		return int64(vv)
+	case []int8:
+		return yoEncodeIntArray(vv)
+	case []int16:
+		return yoEncodeIntArray(vv)
+	case []uint16:
+		return yoEncodeIntArray(vv)
+	case []int32:
+		return yoEncodeIntArray(vv)
+	case []uint32:
+		return yoEncodeIntArray(vv)
+	case []uint64:
+		return yoEncodeIntArray(vv)
+	case []*int8:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint8:
+		return yoEncodeIntPtrArray(vv)
+	case []*int16:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint16:
+		return yoEncodeIntPtrArray(vv)
+	case []*int32:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint32:
+		return yoEncodeIntPtrArray(vv)
+	case []*uint64:
+		return yoEncodeIntPtrArray(vv)
 	default:
 		return v
 	}
 }
 
+// yoInt is a primitive integer type that spanner library does not support. []uint8 is not
+// included because it is []byte for BYTES.
+type yoInt interface {
+	int8 | uint8 | int16 | uint16 | int32 | uint32 | uint64
+}
+
+func yoEncodeIntArray[T yoInt](vv []T) []int64 {
+	if vv == nil {
+		return nil
+	}
+	ret := make([]int64, len(vv))
+	for i, v := range vv {
+		ret[i] = int64(v)
+	}
+	return ret
+}
+
+func yoEncodeIntPtrArray[T yoInt](vv []*T) []*int64 {
+	if vv == nil {
+		return nil
+	}
+	ret := make([]*int64, len(vv))
+	for i, v := range vv {
+		if v != nil {
+			n := int64(*v)
+			ret[i] = &n
+		}
+	}
+	return ret
+}
+
 // yoDecode wraps primitive types that spanner library does not support to decode from spanner types
 // by yoPrimitiveDecoder before passing to spanner functions. Supported primitive types and
 // user defined types that implement spanner.Decoder interface are handled in decoding phase inside
@@ -107,6 +165,9 @@ func yoDecode(ptr interface{}) interface{} {
 	switch ptr.(type) {
 	case *int8, *uint8, *int16, *uint16, *int32, *uint32, *uint64:
 		return &yoPrimitiveDecoder{val: ptr}
+	case *[]int8, *[]int16, *[]uint16, *[]int32, *[]uint32, *[]uint64,
+		*[]*int8, *[]*uint8, *[]*int16, *[]*uint16, *[]*int32, *[]*uint32, *[]*uint64:
+		return &yoPrimitiveArrayDecoder{val: ptr}
 	default:
 		return ptr
 	}
@@ -148,3 +209,103 @@ func (y *yoPrimitiveDecoder) DecodeSpanner(val interface{}) error {
 
 	return nil
 }
+
+// yoPrimitiveArrayDecoder decodes ARRAY<INT64> into a slice of primitive types. The elements
+// are pointers if the array may contain NULL.
+type yoPrimitiveArrayDecoder struct {
+	val interface{}
+}
+
+func (y *yoPrimitiveArrayDecoder) DecodeSpanner(val interface{}) error {
+	var list *structpb.ListValue
+	switch vv := val.(type) {
+	case *structpb.ListValue:
+		list = vv
+	case *string:
+		// NULL
+	default:
+		return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "failed to decode customField: %T(%v)", val, val))
+	}
+
+	switch vv := y.val.(type) {
+	case *[]int8:
+		return yoDecodeIntArray(vv, list)
+	case *[]int16:
+		return yoDecodeIntArray(vv, list)
+	case *[]uint16:
+		return yoDecodeIntArray(vv, list)
+	case *[]int32:
+		return yoDecodeIntArray(vv, list)
+	case *[]uint32:
+		return yoDecodeIntArray(vv, list)
+	case *[]uint64:
+		return yoDecodeIntArray(vv, list)
+	case *[]*int8:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint8:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*int16:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint16:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*int32:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint32:
+		return yoDecodeIntPtrArray(vv, list)
+	case *[]*uint64:
+		return yoDecodeIntPtrArray(vv, list)
+	default:
+		return status.Errorf(codes.Internal, "unexpected type for yoPrimitiveArrayDecoder: %T", y.val)
+	}
+}
+
+func yoDecodeIntArray[T yoInt](ptr *[]T, list *structpb.ListValue) error {
+	vals, err := yoDecodeIntPtrs[T](list)
+	if err != nil {
+		return err
+	}
+	if vals == nil {
+		*ptr = nil
+		return nil
+	}
+
+	ret := make([]T, len(vals))
+	for i, v := range vals {
+		if v == nil {
+			return spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "cannot decode NULL element into %T", *ptr))
+		}
+		ret[i] = *v
+	}
+	*ptr = ret
+	return nil
+}
+
+func yoDecodeIntPtrArray[T yoInt](ptr *[]*T, list *structpb.ListValue) error {
+	vals, err := yoDecodeIntPtrs[T](list)
+	if err != nil {
+		return err
+	}
+	*ptr = vals
+	return nil
+}
+
+func yoDecodeIntPtrs[T yoInt](list *structpb.ListValue) ([]*T, error) {
+	if list == nil {
+		return nil, nil
+	}
+
+	ret := make([]*T, len(list.Values))
+	for i, v := range list.Values {
+		if _, ok := v.Kind.(*structpb.Value_NullValue); ok {
+			continue
+		}
+
+		intVal, err := strconv.ParseInt(v.GetStringValue(), 10, 64)
+		if err != nil {
+			return nil, spanner.ToSpannerError(status.Errorf(codes.FailedPrecondition, "%v wasn't correctly encoded: <%v>", v, err))
+		}
+		n := T(intVal)
+		ret[i] = &n
+	}
+	return ret, nil
+}
@@ -75,6 +75,15 @@ func DeleteAllData(ctx context.Context, client *spanner.Client) error {
 		"FereignItems",
 		"GeneratedColumns",
 		"Inflectionzz",
+		"GrandchildItems",
+		"ChildItems",
+		"ParentItems",
+		"CommitTimestamps",
+		"DefaultValues",
+		"Sessions",
+		"Orders",
+		"ArrayElements",
+		"PointerFields",
 	}
 	var muts []*spanner.Mutation
 	for _, table := range tables {